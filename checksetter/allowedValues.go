@@ -1,7 +1,12 @@
 package checksetter
 
 import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -23,6 +28,123 @@ func getOrderedNames(nameToArgs map[string][]string) []string {
 	return names
 }
 
+// namedChecksDesc returns a string describing the named checks of the
+// registered Parser with the given checker name. It returns the empty
+// string if there are none.
+func namedChecksDesc(checkerName, indent string) string {
+	p, ok := parserRegister[checkerName]
+	if !ok {
+		return ""
+	}
+
+	namedChecks := p.NamedChecks()
+	if len(namedChecks) == 0 {
+		return ""
+	}
+
+	names := slices.Sorted(maps.Keys(namedChecks))
+
+	ncSet := make([]string, 0, len(names)+1)
+	ncSet = append(ncSet, indent+checkerName+" named checks:")
+
+	for _, name := range names {
+		ncSet = append(ncSet, indent+indent+name+": "+namedChecks[name])
+	}
+
+	return strings.Join(ncSet, "\n")
+}
+
+// constantsDesc returns a string describing the constants of the registered
+// Parser with the given checker name. It returns the empty string if there
+// are none.
+func constantsDesc(checkerName, indent string) string {
+	p, ok := parserRegister[checkerName]
+	if !ok {
+		return ""
+	}
+
+	constants := p.Constants()
+	if len(constants) == 0 {
+		return ""
+	}
+
+	names := slices.Sorted(maps.Keys(constants))
+
+	cSet := make([]string, 0, len(names)+1)
+	cSet = append(cSet, indent+checkerName+" constants:")
+
+	for _, name := range names {
+		cSet = append(cSet,
+			indent+indent+fmt.Sprintf("%s = %#v (%T)",
+				name, constants[name], constants[name]))
+	}
+
+	return strings.Join(cSet, "\n")
+}
+
+// unitsDesc returns a string describing the units of the registered Parser
+// with the given checker name. It returns the empty string if there are
+// none or if none of the functions takes a numeric argument which can be
+// given with a unit. If none of those arguments is a floating point number
+// only the units which multiply by a whole number greater than one are
+// shown.
+func unitsDesc(checkerName string, makerFuncs map[string][]string,
+	indent string,
+) string {
+	p, ok := parserRegister[checkerName]
+	if !ok {
+		return ""
+	}
+
+	units := p.Units()
+	if len(units) == 0 {
+		return ""
+	}
+
+	hasNumericArg, hasFloatArg := false, false
+
+	for _, args := range makerFuncs {
+		for _, arg := range args {
+			if bits, ok := numericArgBits[arg]; ok {
+				hasNumericArg = true
+				hasFloatArg = hasFloatArg || bits == 0
+			}
+		}
+	}
+
+	if !hasNumericArg {
+		return ""
+	}
+
+	byMult := map[float64][]string{}
+
+	for _, name := range slices.Sorted(maps.Keys(units)) {
+		mult := units[name]
+		if !hasFloatArg && (mult <= 1 || mult != math.Trunc(mult)) {
+			continue
+		}
+
+		byMult[mult] = append(byMult[mult], name)
+	}
+
+	if len(byMult) == 0 {
+		return ""
+	}
+
+	uSet := make([]string, 0, len(byMult)+1)
+	uSet = append(uSet, indent+checkerName+" units"+
+		" (a numeric argument can be given as a string holding"+
+		" a number followed by a unit, such as \"1.5k\"):")
+
+	for _, mult := range slices.Sorted(maps.Keys(byMult)) {
+		uSet = append(uSet, indent+indent+
+			strings.Join(byMult[mult], ", ")+" = "+
+			strconv.FormatFloat(mult, 'f', -1, 64))
+	}
+
+	return strings.Join(uSet, "\n")
+}
+
 // allowedValFuncs will return a string showing all the allowed values for the
 // given family of check functions. It will also show the allowed values for
 // any referenced families of check functions.
@@ -80,6 +202,18 @@ func allowedValFuncs(checkerName string, makerFuncs map[string][]string) string
 				}
 
 				allowedVals = append(allowedVals, strings.Join(funcSet, "\n"))
+
+				if nc := namedChecksDesc(k, indent); nc != "" {
+					allowedVals = append(allowedVals, nc)
+				}
+
+				if c := constantsDesc(k, indent); c != "" {
+					allowedVals = append(allowedVals, c)
+				}
+
+				if u := unitsDesc(k, v.makerFuncs, indent); u != "" {
+					allowedVals = append(allowedVals, u)
+				}
 			}
 		}
 
@@ -94,6 +228,9 @@ func allowedValFuncs(checkerName string, makerFuncs map[string][]string) string
 func AllowedValues(checkerName string, makerFuncs map[string][]string) string {
 	rval := "a list of " + checkerName + " functions separated by ','." +
 		" Write the checks as if you were writing code." +
+		" A check can be given a name by writing 'name: check';" +
+		" the name can then be used in place of the check" +
+		" elsewhere in the list." +
 		" The functions recognised are:" +
 		"\n\n" +
 		allowedValFuncs(checkerName, makerFuncs)
@@ -2,6 +2,7 @@ package checksetter_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nickwells/checksetter.mod/v4/checksetter"
 	"github.com/nickwells/testhelper.mod/v2/testhelper"
@@ -31,6 +32,9 @@ func TestAllowedValues(t *testing.T) {
 		float64Parser     = checksetter.FindParserOrPanic[float64](checksetter.Float64CheckerName)
 		stringParser      = checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
 		stringSliceParser = checksetter.FindParserOrPanic[[]string](checksetter.StringSliceCheckerName)
+		boolParser        = checksetter.FindParserOrPanic[bool](checksetter.BoolCheckerName)
+		durationParser    = checksetter.FindParserOrPanic[time.Duration](checksetter.DurationCheckerName)
+		pathParser        = checksetter.FindParserOrPanic[string](checksetter.PathCheckerName)
 	)
 
 	testCases := []struct {
@@ -68,6 +72,21 @@ func TestAllowedValues(t *testing.T) {
 			name:       checksetter.StringSliceCheckerName,
 			makerFuncs: stringSliceParser.MakerFuncs(),
 		},
+		{
+			ID:         testhelper.MkID(checksetter.BoolCheckerName),
+			name:       checksetter.BoolCheckerName,
+			makerFuncs: boolParser.MakerFuncs(),
+		},
+		{
+			ID:         testhelper.MkID(checksetter.DurationCheckerName),
+			name:       checksetter.DurationCheckerName,
+			makerFuncs: durationParser.MakerFuncs(),
+		},
+		{
+			ID:         testhelper.MkID(checksetter.PathCheckerName),
+			name:       checksetter.PathCheckerName,
+			makerFuncs: pathParser.MakerFuncs(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,631 @@
+package checksetter
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// orderedArgKinds maps the names of the argument kinds which can be used to
+// bound a range of values to whether or not the values are whole numbers
+var orderedArgKinds = map[string]bool{
+	IntArg.Name:      true,
+	Int64Arg.Name:    true,
+	Float64Arg.Name:  false,
+	DurationArg.Name: true,
+	StringArg.Name:   false,
+}
+
+// integralLimits maps the names of the whole number argument kinds which
+// can be used to bound a range of values to the lowest and highest values
+// of that kind
+var integralLimits = map[string][2]int64{
+	IntArg.Name:      {math.MinInt, math.MaxInt},
+	Int64Arg.Name:    {math.MinInt64, math.MaxInt64},
+	DurationArg.Name: {math.MinInt64, math.MaxInt64},
+}
+
+// rangeInfo records what is known about the values that a check passes:
+// every value that passes is in the may range and every value in the must
+// range passes. A check which cannot be analysed may pass any value and
+// need not pass any.
+//
+// If the values are float64 numbers NaN is also in the domain. It is not
+// in either range as it cannot be ordered; instead mayNaN and mustNaN
+// record whether NaN may pass and must pass the check.
+type rangeInfo struct {
+	may, must valRange
+
+	hasNaN, mayNaN, mustNaN bool
+}
+
+// not returns the rangeInfo for the negation of the check
+func (ri rangeInfo) not() rangeInfo {
+	return rangeInfo{
+		may:     ri.must.complement(),
+		must:    ri.may.complement(),
+		hasNaN:  ri.hasNaN,
+		mayNaN:  ri.hasNaN && !ri.mustNaN,
+		mustNaN: ri.hasNaN && !ri.mayNaN,
+	}
+}
+
+// and returns the rangeInfo for a check which must pass both checks
+func (ri rangeInfo) and(other rangeInfo) rangeInfo {
+	return rangeInfo{
+		may:     ri.may.intersect(other.may),
+		must:    ri.must.intersect(other.must),
+		hasNaN:  ri.hasNaN,
+		mayNaN:  ri.mayNaN && other.mayNaN,
+		mustNaN: ri.mustNaN && other.mustNaN,
+	}
+}
+
+// or returns the rangeInfo for a check which must pass either check
+func (ri rangeInfo) or(other rangeInfo) rangeInfo {
+	return rangeInfo{
+		may:     ri.may.union(other.may),
+		must:    ri.must.union(other.must),
+		hasNaN:  ri.hasNaN,
+		mayNaN:  ri.mayNaN || other.mayNaN,
+		mustNaN: ri.mustNaN || other.mustNaN,
+	}
+}
+
+// isEmpty returns true if no value can pass the check
+func (ri rangeInfo) isEmpty() bool {
+	return ri.may.isEmpty() && !ri.mayNaN
+}
+
+// implies returns true if every value which may pass the check must pass
+// the other check
+func (ri rangeInfo) implies(other rangeInfo) bool {
+	return ri.may.isSubsetOf(other.must) && (!ri.mayNaN || other.mustNaN)
+}
+
+// analyser holds the state of the analysis of a list of checks
+type analyser[T any] struct {
+	p        Parser[T]
+	fset     *token.FileSet
+	kind     string
+	integral bool
+
+	// empty records the checks which can never pass although none of the
+	// checks they are made from is in the same state
+	empty []ast.Expr
+
+	warnings []string
+}
+
+// hasNaN returns true if NaN is one of the values that can be checked
+func (a *analyser[T]) hasNaN() bool {
+	return a.kind == Float64Arg.Name
+}
+
+// clip returns the range holding those values in the range which can be
+// checked; whole numbers are limited by the size of their type. An
+// interval reaching the lowest or highest value is given as unbounded in
+// that direction so that every bounded endpoint is within the limits.
+func (a *analyser[T]) clip(r valRange) valRange {
+	limits, ok := integralLimits[a.kind]
+	if !ok {
+		return r
+	}
+
+	lo := endpoint{val: ordVal{num: big.NewRat(limits[0], 1)}}
+	hi := endpoint{val: ordVal{num: big.NewRat(limits[1], 1)}}
+
+	c := r.intersect(mkRange(true, lo, hi))
+	for i, iv := range c.ivs {
+		if cmpLo(iv.lo, lo) == 0 {
+			c.ivs[i].lo = endpoint{unbounded: true}
+		}
+
+		if cmpHi(iv.hi, hi) == 0 {
+			c.ivs[i].hi = endpoint{unbounded: true}
+		}
+	}
+
+	return c
+}
+
+// exact returns the rangeInfo for a check which passes exactly the values
+// in the range, and NaN if nan is set
+func (a *analyser[T]) exact(r valRange, nan bool) rangeInfo {
+	nan = nan && a.hasNaN()
+
+	return rangeInfo{
+		may: r, must: r,
+		hasNaN: a.hasNaN(), mayNaN: nan, mustNaN: nan,
+	}
+}
+
+// unknown returns the rangeInfo for a check which cannot be analysed
+func (a *analyser[T]) unknown() rangeInfo {
+	return rangeInfo{
+		may: allVals(a.integral), must: noVals(a.integral),
+		hasNaN: a.hasNaN(), mayNaN: a.hasNaN(),
+	}
+}
+
+// src returns the source of the expression, preceded by its position if it
+// was read from a file
+func (a *analyser[T]) src(e ast.Expr) string {
+	s := types.ExprString(e)
+	if a.fset == nil {
+		return s
+	}
+
+	return a.fset.Position(e.Pos()).String() + ": " + s
+}
+
+// infinity is used in place of an infinite float64 value. It is beyond
+// every finite float64 value and so it keeps them in the same order.
+var infinity = new(big.Rat).Mul(
+	new(big.Rat).SetFloat64(math.MaxFloat64), big.NewRat(2, 1)) //nolint:mnd
+
+// decode returns the value of the argument of the CallExpr
+func (a *analyser[T]) decode(e *ast.CallExpr, idx int) (ordVal, error) {
+	switch a.kind {
+	case StringArg.Name:
+		s, err := getString(e.Args[idx])
+		return ordVal{str: s}, err
+	case Float64Arg.Name:
+		f, err := getFloat64(e.Args[idx])
+
+		switch {
+		case math.IsInf(f, 1):
+			return ordVal{num: infinity}, err
+		case math.IsInf(f, -1):
+			return ordVal{num: new(big.Rat).Neg(infinity)}, err
+		}
+
+		return ordVal{num: new(big.Rat).SetFloat64(f)}, err
+	case DurationArg.Name:
+		d, err := DurationArg.Decode(e, idx)
+		return ordVal{num: big.NewRat(int64(d), 1)}, err
+	}
+
+	i, err := getInt64(e.Args[idx])
+
+	return ordVal{num: big.NewRat(i, 1)}, err
+}
+
+// bound returns the endpoint given by the argument of the CallExpr
+func (a *analyser[T]) bound(e *ast.CallExpr, idx int, open bool) (
+	endpoint, error,
+) {
+	v, err := a.decode(e, idx)
+
+	return endpoint{val: v, open: open}, err
+}
+
+// cmpRange returns the range of values passed by a comparison check
+func (a *analyser[T]) cmpRange(name string, e *ast.CallExpr) (
+	valRange, error,
+) {
+	var (
+		lo, hi = endpoint{unbounded: true}, endpoint{unbounded: true}
+		err    error
+	)
+
+	switch name {
+	case "EQ":
+		if lo, err = a.bound(e, 0, false); err == nil {
+			hi = lo
+		}
+	case "GT", "GE":
+		lo, err = a.bound(e, 0, name == "GT")
+	case "LT", "LE":
+		hi, err = a.bound(e, 0, name == "LT")
+	case "Between":
+		if lo, err = a.bound(e, 0, false); err == nil {
+			hi, err = a.bound(e, 1, false)
+		}
+	}
+
+	return a.clip(mkRange(a.integral, lo, hi)), err
+}
+
+// setRange returns the range of values given as the arguments of the
+// CallExpr
+func (a *analyser[T]) setRange(e *ast.CallExpr) (valRange, error) {
+	r := noVals(a.integral)
+
+	for i := range e.Args {
+		pt, err := a.bound(e, i, false)
+		if err != nil {
+			return r, err
+		}
+
+		r = r.union(mkRange(a.integral, pt, pt))
+	}
+
+	return a.clip(r), nil
+}
+
+// hasArgs returns true if the arguments of the maker are as given
+func hasArgs(args []string, expected ...string) bool {
+	if len(args) != len(expected) {
+		return false
+	}
+
+	for i, arg := range args {
+		if arg != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// info returns what can be deduced about the values passed by the check
+func (a *analyser[T]) info(expr ast.Expr) rangeInfo {
+	var (
+		call *ast.CallExpr
+		name string
+	)
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if nc, ok := a.p.namedChecks[e.Name]; ok {
+			return a.info(nc.expr)
+		}
+
+		name = e.Name
+	case *ast.CallExpr:
+		var err error
+		if name, err = getFuncName(e); err != nil {
+			return a.unknown()
+		}
+
+		call = e
+	default:
+		return a.unknown()
+	}
+
+	mi, ok := a.p.makers[name]
+	if !ok {
+		return a.unknown()
+	}
+
+	if call == nil {
+		if name == "OK" && len(mi.Args) == 0 {
+			return a.exact(allVals(a.integral), true)
+		}
+
+		return a.unknown()
+	}
+
+	call, err := a.p.substituteConstants(call, mi.Args)
+	if err == nil {
+		call, err = a.p.substituteUnits(call, mi.Args)
+	}
+
+	if err != nil {
+		return a.unknown()
+	}
+
+	ri, ok := a.callInfo(expr, name, mi.Args, call)
+	if !ok {
+		return a.unknown()
+	}
+
+	return ri
+}
+
+// callInfo returns what can be deduced about the values passed by the
+// check made by calling the named maker. It returns false if the maker is
+// not one that can be analysed.
+func (a *analyser[T]) callInfo(
+	expr ast.Expr, name string, args []string, call *ast.CallExpr,
+) (rangeInfo, bool) {
+	cn := a.p.checkerName
+
+	switch {
+	case (name == "EQ" || name == "GT" || name == "GE" ||
+		name == "LT" || name == "LE") && hasArgs(args, a.kind),
+		name == "Between" && hasArgs(args, a.kind, a.kind):
+		r, err := a.cmpRange(name, call)
+		if err == nil && r.isEmpty() {
+			a.empty = append(a.empty, expr)
+		}
+
+		return a.exact(r, false), err == nil
+	case (name == "OneOf" || name == "NoneOf") &&
+		hasArgs(args, variadicArgMarker, a.kind):
+		r, err := a.setRange(call)
+		if name == "NoneOf" {
+			return a.exact(r.complement(), true), err == nil
+		}
+
+		return a.exact(r, false), err == nil
+	case name == "Not" && hasArgs(args, cn, StringArg.Name):
+		ri, _ := a.combine(expr, call.Args[:1],
+			func(ri []rangeInfo) rangeInfo { return ri[0].not() })
+
+		return ri, true
+	case (name == "If" || name == "Implies") && hasArgs(args, cn, cn):
+		ri, _ := a.combine(expr, call.Args,
+			func(ri []rangeInfo) rangeInfo { return ri[0].not().or(ri[1]) })
+
+		return ri, true
+	case name == "IfElse" && hasArgs(args, cn, cn, cn):
+		ri, _ := a.combine(expr, call.Args,
+			func(ri []rangeInfo) rangeInfo {
+				return ri[0].and(ri[1]).or(ri[0].not().and(ri[2]))
+			})
+
+		return ri, true
+	case name == "And" && hasArgs(args, variadicArgMarker, cn):
+		return a.and(expr, call.Args, "in "+types.ExprString(expr)), true
+	case (name == "Or" || name == "None") &&
+		hasArgs(args, variadicArgMarker, cn):
+		ri := a.or(expr, call.Args)
+		if name == "None" {
+			ri = ri.not()
+		}
+
+		return ri, true
+	}
+
+	return rangeInfo{}, false
+}
+
+// combine returns the rangeInfo made by the combining func from the
+// rangeInfo of the nested checks, which it also returns. If the result is
+// empty but none of the nested checks are, the expression is recorded as
+// one that can never pass.
+func (a *analyser[T]) combine(
+	expr ast.Expr, args []ast.Expr, f func([]rangeInfo) rangeInfo,
+) (rangeInfo, []rangeInfo) {
+	infos := make([]rangeInfo, 0, len(args))
+	anyEmpty := false
+
+	for _, arg := range args {
+		ri := a.info(arg)
+		anyEmpty = anyEmpty || ri.isEmpty()
+		infos = append(infos, ri)
+	}
+
+	ri := f(infos)
+	if ri.isEmpty() && !anyEmpty {
+		a.empty = append(a.empty, expr)
+	}
+
+	return ri, infos
+}
+
+// and returns the rangeInfo for a check that all of the checks must pass.
+// Any check which is implied by the others is reported as redundant; the
+// where string says where the checks are.
+func (a *analyser[T]) and(expr ast.Expr, args []ast.Expr, where string,
+) rangeInfo {
+	ri, infos := a.combine(expr, args, func(infos []rangeInfo) rangeInfo {
+		all := a.exact(allVals(a.integral), true)
+		for _, ri := range infos {
+			all = all.and(ri)
+		}
+
+		return all
+	})
+
+	if ri.isEmpty() || len(args) < 2 { //nolint:mnd
+		return ri
+	}
+
+	kept := make([]bool, len(args))
+	for i := range kept {
+		kept[i] = true
+	}
+
+	for i, arg := range args {
+		others := a.exact(allVals(a.integral), true)
+
+		for j, ri := range infos {
+			if j != i && kept[j] {
+				others = others.and(ri)
+			}
+		}
+
+		if others.implies(infos[i]) {
+			kept[i] = false
+			a.warnings = append(a.warnings,
+				fmt.Sprintf("%s is redundant %s: the other checks imply it",
+					a.src(arg), where))
+		}
+	}
+
+	return ri
+}
+
+// or returns the rangeInfo for a check that at least one of the checks must
+// pass. Any check which can only pass values passed by the others is
+// reported as redundant.
+func (a *analyser[T]) or(expr ast.Expr, args []ast.Expr) rangeInfo {
+	ri, infos := a.combine(expr, args, func(infos []rangeInfo) rangeInfo {
+		some := a.exact(noVals(a.integral), false)
+		for _, ri := range infos {
+			some = some.or(ri)
+		}
+
+		return some
+	})
+
+	if len(args) < 2 { //nolint:mnd
+		return ri
+	}
+
+	kept := make([]bool, len(args))
+	for i := range kept {
+		kept[i] = true
+	}
+
+	for i, arg := range args {
+		if infos[i].isEmpty() {
+			continue
+		}
+
+		others := a.exact(noVals(a.integral), false)
+
+		for j, ri := range infos {
+			if j != i && kept[j] {
+				others = others.or(ri)
+			}
+		}
+
+		if infos[i].implies(others) {
+			kept[i] = false
+			a.warnings = append(a.warnings,
+				fmt.Sprintf("%s is redundant in %s: the other checks cover it",
+					a.src(arg), types.ExprString(expr)))
+		}
+	}
+
+	return ri
+}
+
+// orderedKind returns the kind of the argument of the Parser's comparison
+// checks and whether that kind is a whole number. It returns false if the
+// Parser has no such checks.
+func (p Parser[T]) orderedKind() (string, bool, bool) {
+	for _, name := range []string{"GT", "GE", "LT", "LE", "EQ"} {
+		mi, ok := p.makers[name]
+		if !ok || len(mi.Args) != 1 {
+			continue
+		}
+
+		if integral, ok := orderedArgKinds[mi.Args[0]]; ok {
+			return mi.Args[0], integral, true
+		}
+	}
+
+	return "", false, false
+}
+
+// analyseList returns an analyser holding the results of analysing the
+// elements of the list of checks, which must already have been
+// successfully parsed. The list of checks (with any local definitions
+// expanded) and what is known about the values passing them is also
+// returned. It returns a nil analyser if the Parser has no comparison
+// checks.
+func (p Parser[T]) analyseList(elts []ast.Expr, fset *token.FileSet) (
+	*analyser[T], *ast.CompositeLit, rangeInfo, error,
+) {
+	kind, integral, ok := p.orderedKind()
+	if !ok {
+		return nil, nil, rangeInfo{}, nil
+	}
+
+	exprs, defs, err := p.splitLocalDefs(elts)
+	if err != nil {
+		return nil, nil, rangeInfo{}, err
+	}
+
+	for i, e := range exprs {
+		exprs[i] = p.expandLocalDefs(e, defs)
+	}
+
+	a := &analyser[T]{p: p, fset: fset, kind: kind, integral: integral}
+
+	list := &ast.CompositeLit{Elts: exprs}
+	if len(exprs) > 0 {
+		list.Lbrace = exprs[0].Pos()
+	}
+
+	return a, list, a.and(list, exprs, "in the list"), nil
+}
+
+// analyseElts analyses the elements of the list of checks, which must
+// already have been successfully parsed. It returns a list of warnings and
+// an error if no value could pass all the checks.
+func (p Parser[T]) analyseElts(elts []ast.Expr, fset *token.FileSet) (
+	[]string, error,
+) {
+	a, list, ri, err := p.analyseList(elts, fset)
+	if a == nil || err != nil {
+		return nil, err
+	}
+
+	exprs := list.Elts
+
+	if !ri.isEmpty() {
+		for _, e := range a.empty {
+			a.warnings = append(a.warnings,
+				fmt.Sprintf("%s can never pass", a.src(e)))
+		}
+
+		return a.warnings, nil
+	}
+
+	errs := make([]error, 0, len(a.empty))
+
+	for _, e := range a.empty {
+		if e == ast.Expr(list) {
+			srcs := make([]string, 0, len(exprs))
+			for _, e := range exprs {
+				srcs = append(srcs, a.src(e))
+			}
+
+			errs = append(errs,
+				fmt.Errorf("the %s checks can never all pass: %s",
+					p.checkerName, strings.Join(srcs, ", ")))
+
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s can never pass", a.src(e)))
+	}
+
+	return a.warnings, errors.Join(errs...)
+}
+
+// Analyse parses the list of checks, as for the Parse method, and then
+// looks for problems that would only otherwise be found when values are
+// checked. Comparisons (EQ, GT, GE, LT, LE, Between, OneOf and NoneOf)
+// are tracked through And, Or, Not and the other combining checks. If no
+// value could ever pass all the checks an error is returned. A check
+// which can never pass, or which is made redundant by the others (as in
+// 'GT(1), GT(5)'), is reported in the returned warnings. Whole numbers
+// are limited by the size of their type so 'GT(9223372036854775807)' can
+// never pass, and a float64 NaN, which fails every comparison but passes
+// its negation, is allowed for.
+//
+// Only those Parsers which have comparison checks of int, int64, float64,
+// duration or string values can be analysed; for any other Parser only the
+// parsing is done. Checks other than those listed above are treated as if
+// they could pass any value.
+func (p Parser[T]) Analyse(s string) ([]string, error) {
+	elts, err := p.listElts(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.makeCheckFuncs(elts, nil); err != nil {
+		return nil, err
+	}
+
+	return p.analyseElts(elts, nil)
+}
+
+// AnalyseFile reads and parses the checks in the named file, as for the
+// ParseFile method, and then analyses them as for the Analyse method. Any
+// warnings and errors are reported with the position of the check in the
+// file.
+func (p Parser[T]) AnalyseFile(fileName string) ([]string, error) {
+	elts, fset, err := p.fileElts(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.makeCheckFuncs(elts, fset); err != nil {
+		return nil, err
+	}
+
+	return p.analyseElts(elts, fset)
+}
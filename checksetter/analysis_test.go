@@ -0,0 +1,232 @@
+package checksetter_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestAnalyse(t *testing.T) {
+	pInt := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+	pFloat := checksetter.FindParserOrPanic[float64](
+		checksetter.Float64CheckerName)
+	pString := checksetter.FindParserOrPanic[string](
+		checksetter.StringCheckerName)
+	pDur := checksetter.FindParserOrPanic[time.Duration](
+		checksetter.DurationCheckerName)
+	pBool := checksetter.FindParserOrPanic[bool](checksetter.BoolCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		analyse     func(string) ([]string, error)
+		expr        string
+		expWarnings []string
+	}{
+		{
+			ID:      testhelper.MkID("good: no problems"),
+			analyse: pInt.Analyse,
+			expr:    "GT(1), LT(5), Not(EQ(3), \"not 3\")",
+		},
+		{
+			ID: testhelper.MkID("bad: GT/LT"),
+			ExpErr: testhelper.MkExpErr(
+				"the int-checker checks can never all pass: GT(10), LT(5)"),
+			analyse: pInt.Analyse,
+			expr:    "GT(10), LT(5)",
+		},
+		{
+			ID: testhelper.MkID("bad: no whole number between"),
+			ExpErr: testhelper.MkExpErr(
+				"the int-checker checks can never all pass: GT(5), LT(6)"),
+			analyse: pInt.Analyse,
+			expr:    "GT(5), LT(6)",
+		},
+		{
+			ID:      testhelper.MkID("good: a float between"),
+			analyse: pFloat.Analyse,
+			expr:    "GT(5), LT(6)",
+		},
+		{
+			ID: testhelper.MkID("bad: nested And"),
+			ExpErr: testhelper.MkExpErr(
+				"And(GE(10), OneOf(1, 2, 3)) can never pass"),
+			analyse: pInt.Analyse,
+			expr:    "OK, And(GE(10), OneOf(1, 2, 3))",
+		},
+		{
+			ID: testhelper.MkID("bad: Not"),
+			ExpErr: testhelper.MkExpErr(
+				"the int-checker checks can never all pass:",
+				`Not(Between(0, 9), "x"), OneOf(1, 5)`),
+			analyse: pInt.Analyse,
+			expr:    `Not(Between(0, 9), "x"), OneOf(1, 5)`,
+		},
+		{
+			ID:      testhelper.MkID("warning: redundant"),
+			analyse: pInt.Analyse,
+			expr:    "GT(1), GT(5)",
+			expWarnings: []string{
+				"GT(1) is redundant in the list: the other checks imply it",
+			},
+		},
+		{
+			ID:      testhelper.MkID("warning: redundant in And"),
+			analyse: pInt.Analyse,
+			expr:    "And(LE(9), LT(100)), NoneOf(3)",
+			expWarnings: []string{
+				"LT(100) is redundant in And(LE(9), LT(100)):" +
+					" the other checks imply it",
+			},
+		},
+		{
+			ID:      testhelper.MkID("warning: Or branch never passes"),
+			analyse: pInt.Analyse,
+			expr:    "Or(LT(0), And(GT(10), LT(5)))",
+			expWarnings: []string{
+				"And(GT(10), LT(5)) can never pass",
+			},
+		},
+		{
+			ID:      testhelper.MkID("warning: Or branch covered"),
+			analyse: pInt.Analyse,
+			expr:    "Or(LT(10), EQ(3))",
+			expWarnings: []string{
+				"EQ(3) is redundant in Or(LT(10), EQ(3)):" +
+					" the other checks cover it",
+			},
+		},
+		{
+			ID:      testhelper.MkID("good: unknown checks are not redundant"),
+			analyse: pInt.Analyse,
+			expr:    "GT(0), Divides(60)",
+		},
+		{
+			ID: testhelper.MkID("bad: string ordering"),
+			ExpErr: testhelper.MkExpErr(
+				`the string-checker checks can never all pass:` +
+					` GE("m"), LT("b")`),
+			analyse: pString.Analyse,
+			expr:    `GE("m"), LT("b"), HasPrefix("x")`,
+		},
+		{
+			ID: testhelper.MkID("bad: durations and units"),
+			ExpErr: testhelper.MkExpErr(
+				`the duration-checker checks can never all pass:` +
+					` GT("1h"), LT("30m")`),
+			analyse: pDur.Analyse,
+			expr:    `GT("1h"), LT("30m")`,
+		},
+		{
+			ID: testhelper.MkID("bad: Inf"),
+			ExpErr: testhelper.MkExpErr(
+				"the float64-checker checks can never all pass:" +
+					" GT(Inf), LT(Inf)"),
+			analyse: pFloat.Analyse,
+			expr:    "GT(Inf), LT(Inf)",
+		},
+		{
+			ID:      testhelper.MkID("good: NaN passes both negations"),
+			analyse: pFloat.Analyse,
+			expr:    `Not(GE(0), "x"), Not(LT(0), "y")`,
+		},
+		{
+			ID: testhelper.MkID("bad: NaN fails a comparison"),
+			ExpErr: testhelper.MkExpErr(
+				"the float64-checker checks can never all pass:" +
+					` Not(GE(0), "x"), Not(LT(0), "y"), LT(1)`),
+			analyse: pFloat.Analyse,
+			expr:    `Not(GE(0), "x"), Not(LT(0), "y"), LT(1)`,
+		},
+		{
+			ID: testhelper.MkID("bad: beyond the largest int"),
+			ExpErr: testhelper.MkExpErr(
+				"GT(9223372036854775807) can never pass"),
+			analyse: pInt.Analyse,
+			expr:    "GT(9223372036854775807)",
+		},
+		{
+			ID: testhelper.MkID("bad: beyond the largest duration"),
+			ExpErr: testhelper.MkExpErr(
+				`GT("2562047h47m16.854775807s") can never pass`),
+			analyse: pDur.Analyse,
+			expr:    `GT("2562047h47m16.854775807s")`,
+		},
+		{
+			ID:      testhelper.MkID("warning: up to the largest int"),
+			analyse: pInt.Analyse,
+			expr:    "LE(9223372036854775807), GT(0)",
+			expWarnings: []string{
+				"LE(9223372036854775807) is redundant in the list:" +
+					" the other checks imply it",
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: IfElse"),
+			ExpErr: testhelper.MkExpErr(
+				"the int-checker checks can never all pass:" +
+					" IfElse(GT(0), LT(10), GT(100)), Between(20, 30)"),
+			analyse: pInt.Analyse,
+			expr:    "IfElse(GT(0), LT(10), GT(100)), Between(20, 30)",
+		},
+		{
+			ID:      testhelper.MkID("good: family which can't be analysed"),
+			analyse: pBool.Analyse,
+			expr:    "IsTrue, IsFalse",
+		},
+		{
+			ID: testhelper.MkID("bad: parse error"),
+			ExpErr: testhelper.MkExpErr(
+				"can't make int-checker function:", "Nonesuch"),
+			analyse: pInt.Analyse,
+			expr:    "Nonesuch",
+		},
+		{
+			ID: testhelper.MkID("bad: file"),
+			ExpErr: testhelper.MkExpErr(
+				filepath.Join(checkFileDir, "unsatisfiable.chk")+":3:1:",
+				"And(GT(100), LT(5)) can never pass"),
+			analyse: pInt.AnalyseFile,
+			expr:    filepath.Join(checkFileDir, "unsatisfiable.chk"),
+		},
+	}
+
+	for _, tc := range testCases {
+		warnings, err := tc.analyse(tc.expr)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffStringSlice(t, tc.IDStr(), "warnings",
+				warnings, tc.expWarnings)
+		}
+	}
+}
+
+func TestSetterAnalyse(t *testing.T) {
+	value := []check.ValCk[int]{}
+	s := checksetter.Setter[int]{
+		Value:   &value,
+		Parser:  checksetter.FindParserOrPanic[int](checksetter.IntCheckerName),
+		Analyse: true,
+	}
+
+	err := s.SetWithVal("", "GT(10), LT(5)")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("unsatisfiable"),
+		ExpErr: testhelper.MkExpErr("can never all pass"),
+	})
+	testhelper.DiffInt(t, "unsatisfiable", "number of checks", len(value), 0)
+
+	if err := s.SetWithVal("", "GE(1), GE(0)"); err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	testhelper.DiffInt(t, "redundant", "number of checks", len(value), 2)
+	testhelper.DiffStringSlice(t, "redundant", "warnings", s.Warnings(),
+		[]string{"GE(0) is redundant in the list: the other checks imply it"})
+}
@@ -0,0 +1,241 @@
+package checksetter
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Number is the set of types for which the bounds of a list of checks can
+// be derived
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+// Limit is one end of an Interval. If Unbounded is set the Interval
+// extends indefinitely in that direction and the other fields are unused.
+// If Open is set the value itself is not in the Interval.
+type Limit[T Number] struct {
+	Val       T
+	Open      bool
+	Unbounded bool
+}
+
+// Interval is a contiguous range of values from Lo to Hi
+type Interval[T Number] struct {
+	Lo, Hi Limit[T]
+}
+
+// Bounds describes the values which may pass a list of checks as a set of
+// disjoint Intervals in increasing order. If there are no Intervals no
+// value can pass the checks.
+type Bounds[T Number] struct {
+	Intervals []Interval[T]
+
+	// Exact is set if every value in the Intervals passes the checks and
+	// no other value does. It is false if some of the checks could not be
+	// analysed (see Parser.Analyse) in which case some of the values in
+	// the Intervals may still fail. It is also false if the checks may
+	// pass a float64 NaN, which is not in any Interval.
+	Exact bool
+
+	integral bool
+}
+
+// Min returns the lowest value that may pass the checks. It returns false
+// if there is no lower bound or no value can pass. Note that the value
+// itself may not pass if the lower Limit is Open.
+func (b Bounds[T]) Min() (T, bool) {
+	if len(b.Intervals) == 0 || b.Intervals[0].Lo.Unbounded {
+		return 0, false
+	}
+
+	return b.Intervals[0].Lo.Val, true
+}
+
+// Max returns the highest value that may pass the checks. It returns false
+// if there is no upper bound or no value can pass. Note that the value
+// itself may not pass if the upper Limit is Open.
+func (b Bounds[T]) Max() (T, bool) {
+	n := len(b.Intervals)
+	if n == 0 || b.Intervals[n-1].Hi.Unbounded {
+		return 0, false
+	}
+
+	return b.Intervals[n-1].Hi.Val, true
+}
+
+// excludedPoint returns the single value in the gap between the Intervals
+// and true or false if the gap holds more than one value
+func (b Bounds[T]) excludedPoint(before, after Interval[T]) (T, bool) {
+	hi, lo := before.Hi, after.Lo
+	if b.integral {
+		return hi.Val + 1, lo.Val-hi.Val == 2 //nolint:mnd
+	}
+
+	return hi.Val, hi.Open && lo.Open && hi.Val == lo.Val
+}
+
+// String returns a description of the Bounds in interval notation. Where
+// Intervals are separated by single values they are described as one
+// Interval excluding those values, for instance "[1, 65535] excluding
+// {8080}".
+func (b Bounds[T]) String() string {
+	if len(b.Intervals) == 0 {
+		return "no values"
+	}
+
+	var (
+		parts    []string
+		excluded []string
+		start    = b.Intervals[0]
+	)
+
+	addPart := func(end Interval[T]) {
+		part := Interval[T]{Lo: start.Lo, Hi: end.Hi}.String()
+		if len(excluded) > 0 {
+			part += " excluding {" + strings.Join(excluded, ", ") + "}"
+		}
+
+		parts = append(parts, part)
+		excluded = nil
+	}
+
+	for i := 1; i < len(b.Intervals); i++ {
+		prev, iv := b.Intervals[i-1], b.Intervals[i]
+		if pt, ok := b.excludedPoint(prev, iv); ok {
+			excluded = append(excluded, fmt.Sprint(pt))
+			continue
+		}
+
+		addPart(prev)
+		start = iv
+	}
+
+	addPart(b.Intervals[len(b.Intervals)-1])
+
+	return strings.Join(parts, " or ")
+}
+
+// String returns a description of the Interval in interval notation
+func (iv Interval[T]) String() string {
+	if !iv.Lo.Unbounded && !iv.Hi.Unbounded && iv.Lo.Val == iv.Hi.Val {
+		return fmt.Sprint(iv.Lo.Val)
+	}
+
+	s := "(-Inf, "
+	if !iv.Lo.Unbounded {
+		s = fmt.Sprintf("(%v, ", iv.Lo.Val)
+		if !iv.Lo.Open {
+			s = fmt.Sprintf("[%v, ", iv.Lo.Val)
+		}
+	}
+
+	switch {
+	case iv.Hi.Unbounded:
+		return s + "+Inf)"
+	case iv.Hi.Open:
+		return s + fmt.Sprintf("%v)", iv.Hi.Val)
+	}
+
+	return s + fmt.Sprintf("%v]", iv.Hi.Val)
+}
+
+// ratToNumber converts the value to a T. The value used for an infinite
+// float64 is converted back to an infinity. It returns false if an integer
+// value is too big for a T.
+func ratToNumber[T Number](r *big.Rat, integral bool) (T, bool) {
+	if !integral {
+		switch {
+		case r.Cmp(infinity) == 0:
+			return T(math.Inf(1)), true
+		case new(big.Rat).Neg(r).Cmp(infinity) == 0:
+			return T(math.Inf(-1)), true
+		}
+
+		f, _ := r.Float64()
+
+		return T(f), true
+	}
+
+	n := r.Num()
+	if !n.IsInt64() {
+		return 0, false
+	}
+
+	v := T(n.Int64())
+
+	return v, int64(v) == n.Int64()
+}
+
+// toLimit converts the endpoint to a Limit. It returns an error if the
+// value of the endpoint cannot be represented by a T.
+func toLimit[T Number](e endpoint, integral bool) (Limit[T], error) {
+	if e.unbounded {
+		return Limit[T]{Unbounded: true}, nil
+	}
+
+	v, ok := ratToNumber[T](e.val.num, integral)
+	if !ok {
+		return Limit[T]{},
+			fmt.Errorf("the bound %s is too big for a %T",
+				e.val.num.RatString(), v)
+	}
+
+	return Limit[T]{Val: v, Open: e.open}, nil
+}
+
+// DeriveBounds parses the list of checks, as for the Parser's Parse method,
+// and returns the Bounds of the values which may pass them. The bounds are
+// derived from the comparison checks as described for the Parser's Analyse
+// method; if there are other checks the Bounds will not be Exact. A bound
+// at the lowest or highest whole number of the type is given as unbounded.
+// It returns an error if the checks cannot be parsed, if the Parser does
+// not have comparison checks of numbers or if a bound cannot be
+// represented by a T.
+//
+// For instance, for the int-checker, 'Between(1, 65535), NoneOf(8080)'
+// gives Bounds described as "[1, 65535] excluding {8080}".
+func DeriveBounds[T Number](p Parser[T], s string) (Bounds[T], error) {
+	elts, err := p.listElts(s)
+	if err != nil {
+		return Bounds[T]{}, err
+	}
+
+	if _, err := p.makeCheckFuncs(elts, nil); err != nil {
+		return Bounds[T]{}, err
+	}
+
+	a, _, ri, err := p.analyseList(elts, nil)
+	if err != nil {
+		return Bounds[T]{}, err
+	}
+
+	if a == nil || a.kind == StringArg.Name {
+		return Bounds[T]{},
+			fmt.Errorf("can't derive the bounds of the %s checks:"+
+				" there are no comparisons of numbers", p.checkerName)
+	}
+
+	b := Bounds[T]{
+		Exact:    ri.implies(ri) && !ri.mayNaN,
+		integral: a.integral,
+	}
+
+	for _, iv := range ri.may.ivs {
+		lo, err := toLimit[T](iv.lo, a.integral)
+		if err != nil {
+			return Bounds[T]{}, err
+		}
+
+		hi, err := toLimit[T](iv.hi, a.integral)
+		if err != nil {
+			return Bounds[T]{}, err
+		}
+
+		b.Intervals = append(b.Intervals, Interval[T]{Lo: lo, Hi: hi})
+	}
+
+	return b, nil
+}
@@ -0,0 +1,177 @@
+package checksetter_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestDeriveBoundsInt(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr     string
+		expDesc  string
+		expExact bool
+		expMin   int
+		hasMin   bool
+		expMax   int
+		hasMax   bool
+	}{
+		{
+			ID:       testhelper.MkID("port with an exclusion"),
+			expr:     "Between(1, 65535), NoneOf(8080)",
+			expDesc:  "[1, 65535] excluding {8080}",
+			expExact: true,
+			expMin:   1,
+			hasMin:   true,
+			expMax:   65535,
+			hasMax:   true,
+		},
+		{
+			ID:       testhelper.MkID("open bounds are closed for ints"),
+			expr:     "GT(0), LT(10)",
+			expDesc:  "[1, 9]",
+			expExact: true,
+			expMin:   1,
+			hasMin:   true,
+			expMax:   9,
+			hasMax:   true,
+		},
+		{
+			ID:       testhelper.MkID("Or and Not"),
+			expr:     `Or(LT(10), GT(100)), Not(EQ(5), "x"), NoneOf(9)`,
+			expDesc:  "(-Inf, 8] excluding {5} or [101, +Inf)",
+			expExact: true,
+		},
+		{
+			ID:       testhelper.MkID("not exact"),
+			expr:     "GE(0), Divides(60)",
+			expDesc:  "[0, +Inf)",
+			expExact: false,
+			expMin:   0,
+			hasMin:   true,
+		},
+		{
+			ID:       testhelper.MkID("no values"),
+			expr:     "GT(10), LT(5)",
+			expDesc:  "no values",
+			expExact: true,
+		},
+		{
+			ID:       testhelper.MkID("beyond the largest int"),
+			expr:     "GT(9223372036854775807)",
+			expDesc:  "no values",
+			expExact: true,
+		},
+		{
+			ID:       testhelper.MkID("up to the largest int"),
+			expr:     "GE(9223372036854775807)",
+			expDesc:  "[9223372036854775807, +Inf)",
+			expExact: true,
+			expMin:   math.MaxInt64,
+			hasMin:   true,
+		},
+		{
+			ID:       testhelper.MkID("a single value"),
+			expr:     "OneOf(3)",
+			expDesc:  "3",
+			expExact: true,
+			expMin:   3,
+			hasMin:   true,
+			expMax:   3,
+			hasMax:   true,
+		},
+		{
+			ID:     testhelper.MkID("bad checks"),
+			ExpErr: testhelper.MkExpErr("can't make int-checker function"),
+			expr:   "Nonesuch",
+		},
+	}
+
+	for _, tc := range testCases {
+		b, err := checksetter.DeriveBounds(*p, tc.expr)
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "description",
+			b.String(), tc.expDesc)
+		testhelper.DiffBool(t, tc.IDStr(), "exact", b.Exact, tc.expExact)
+
+		minVal, hasMin := b.Min()
+		if testhelper.DiffBool(t, tc.IDStr(), "has min", hasMin, tc.hasMin) {
+			testhelper.DiffInt(t, tc.IDStr(), "min", minVal, tc.expMin)
+		}
+
+		maxVal, hasMax := b.Max()
+		if testhelper.DiffBool(t, tc.IDStr(), "has max", hasMax, tc.hasMax) {
+			testhelper.DiffInt(t, tc.IDStr(), "max", maxVal, tc.expMax)
+		}
+	}
+}
+
+func TestDeriveBoundsOther(t *testing.T) {
+	pFloat := checksetter.FindParserOrPanic[float64](
+		checksetter.Float64CheckerName)
+
+	b, err := checksetter.DeriveBounds(*pFloat,
+		"GT(0), LE(Inf), NoneOf(1.5)")
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	testhelper.DiffString(t, "float64", "description", b.String(),
+		"(0, +Inf] excluding {1.5}")
+	testhelper.DiffBool(t, "float64", "exact", b.Exact, true)
+
+	maxVal, _ := b.Max()
+	testhelper.DiffBool(t, "float64", "max is +Inf",
+		math.IsInf(maxVal, 1), true)
+
+	bNaN, err := checksetter.DeriveBounds(*pFloat, `Not(Between(0, 1), "x")`)
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	testhelper.DiffString(t, "float64 NaN", "description", bNaN.String(),
+		"(-Inf, 0) or (1, +Inf)")
+	testhelper.DiffBool(t, "float64 NaN", "exact", bNaN.Exact, false)
+
+	pDur := checksetter.FindParserOrPanic[time.Duration](
+		checksetter.DurationCheckerName)
+
+	bDur, err := checksetter.DeriveBounds(*pDur, `Between("1s", "1h")`)
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	testhelper.DiffString(t, "duration", "description", bDur.String(),
+		"[1s, 1h0m0s]")
+
+	pNoCmp, err := checksetter.MakeParser("TestDeriveBoundsNoCmp",
+		map[string]checksetter.MakerInfo[int]{
+			"OK": checksetter.MakerNoArgs(
+				map[string]check.ValCk[int]{"OK": check.ValOK[int]}),
+		})
+	if err != nil {
+		t.Fatal("couldn't make the parser: " + err.Error())
+	}
+
+	_, err = checksetter.DeriveBounds(pNoCmp, "OK")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("no comparisons"),
+		ExpErr: testhelper.MkExpErr(
+			"can't derive the bounds of the TestDeriveBoundsNoCmp checks:",
+			"there are no comparisons of numbers"),
+	})
+}
@@ -0,0 +1,145 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"os"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// ParseFile reads the named file and parses its contents as a list of
+// checks, as for the Parse method. The file may contain comments, which are
+// ignored, and a check may be split over several lines. The checks may be
+// separated by commas or given on separate lines; as in Go, a check
+// continues onto the next line if the line ends with something that cannot
+// end a check, such as a '(', a ',' or an operator. Any error is reported
+// with the file name, line and column of the problem.
+func (p Parser[T]) ParseFile(fileName string) ([]check.ValCk[T], error) {
+	elts, fset, err := p.fileElts(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.makeCheckFuncs(elts, fset)
+}
+
+// fileElts reads the named file and converts its contents into a slice of
+// expressions, allowing operators if the Parser permits them. It returns
+// the FileSet which can be used to find the position of each expression in
+// the file.
+func (p Parser[T]) fileElts(fileName string) (
+	[]ast.Expr, *token.FileSet, error,
+) {
+	src, err := p.readFileSrc(fileName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.srcElts(src, fileName)
+}
+
+// readFileSrc reads the named file and returns its contents prepared for
+// parsing (see prepareFileSrc)
+func (p Parser[T]) readFileSrc(fileName string) (string, error) {
+	content, err := os.ReadFile(fileName) //nolint:gosec
+	if err != nil {
+		return "", fmt.Errorf("can't read the %s file: %w",
+			p.checkerName, err)
+	}
+
+	return prepareFileSrc(string(content)), nil
+}
+
+// srcElts converts the source into a slice of expressions, allowing
+// operators if the Parser permits them. It returns the FileSet which gives
+// the position of each expression relative to the start of the source,
+// using the file name if it is not empty.
+func (p Parser[T]) srcElts(src, fileName string) (
+	[]ast.Expr, *token.FileSet, error,
+) {
+	if p.operators {
+		return p.getEltsWithOperators(src, fileName)
+	}
+
+	return getFileElts(src, fileName, p.checkerName)
+}
+
+// prepareFileSrc returns the contents of a file of checks converted into a
+// list of checks that can be parsed. The comments are replaced by spaces
+// and a comma is added at the end of each line where Go would insert a
+// semicolon. The line and column of every check in the returned string is
+// the same as in the original.
+func prepareFileSrc(s string) string {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(s))
+
+	var sc scanner.Scanner
+
+	sc.Init(file, []byte(s), nil, scanner.ScanComments)
+
+	src := []byte(s)
+	commaAt := []int{}
+
+	for {
+		pos, tok, lit := sc.Scan()
+		if tok == token.EOF {
+			break
+		}
+
+		switch {
+		case tok == token.COMMENT:
+			offset := file.Offset(pos)
+			copy(src[offset:], blankOut(lit))
+		case tok == token.SEMICOLON && lit == "\n":
+			commaAt = append(commaAt, file.Offset(pos))
+		}
+	}
+
+	prepared := make([]byte, 0, len(src)+len(commaAt))
+	start := 0
+
+	for _, offset := range commaAt {
+		prepared = append(prepared, src[start:offset]...)
+		prepared = append(prepared, ',')
+		start = offset
+	}
+
+	return string(append(prepared, src[start:]...))
+}
+
+// getFileElts converts the string read from the named file into a slice of
+// expressions. It returns the FileSet which can be used to find the
+// position of each expression in the file.
+func getFileElts(s, fileName, desc string) (
+	e []ast.Expr, fset *token.FileSet, err error,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, fset, err = nil, nil,
+				fmt.Errorf("%s: unexpected parse error: %q", desc, r)
+		}
+	}()
+
+	// The line directive makes the positions of the expressions relative to
+	// the start of the file
+	fset = token.NewFileSet()
+
+	expr, err := parser.ParseExprFrom(fset, "",
+		"[]T{\n//line "+fileName+":1:1\n"+s+"}", 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cl, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil, nil,
+			fmt.Errorf("unexpected type for the collection of %s: %T",
+				desc, expr)
+	}
+
+	return cl.Elts, fset, nil
+}
@@ -0,0 +1,128 @@
+package checksetter_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+const checkFileDir = "testdata/checkFiles"
+
+func TestParseFile(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+	pOps := p.WithOperators()
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		p           *checksetter.Parser[int]
+		fileName    string
+		expLen      int
+		passingVals []int
+		failingVals []int
+	}{
+		{
+			ID:          testhelper.MkID("good: comments and multi-line checks"),
+			p:           p,
+			fileName:    "good.chk",
+			expLen:      3,
+			passingVals: []int{1024, 8081, 65535},
+			failingVals: []int{1023, 8080, 65536},
+		},
+		{
+			ID:          testhelper.MkID("good: named checks"),
+			p:           p,
+			fileName:    "named.chk",
+			expLen:      1,
+			passingVals: []int{0, 9, 99},
+			failingVals: []int{-1, 10},
+		},
+		{
+			ID:          testhelper.MkID("good: operators"),
+			p:           pOps,
+			fileName:    "operators.chk",
+			expLen:      1,
+			passingVals: []int{-1, 0, 99},
+			failingVals: []int{-2, 100},
+		},
+		{
+			ID: testhelper.MkID("bad: syntax error"),
+			ExpErr: testhelper.MkExpErr(
+				filepath.Join(checkFileDir, "badSyntax.chk")+":3:6:",
+				"missing ','"),
+			p:        p,
+			fileName: "badSyntax.chk",
+		},
+		{
+			ID: testhelper.MkID("bad: bad check"),
+			ExpErr: testhelper.MkExpErr(
+				filepath.Join(checkFileDir, "badCheck.chk")+":4:4:",
+				"can't make int-checker function:",
+				`LE(int): "\"x\"" isn't an INT, it's a STRING`),
+			p:        p,
+			fileName: "badCheck.chk",
+		},
+		{
+			ID: testhelper.MkID("bad: bad operator value"),
+			ExpErr: testhelper.MkExpErr(
+				filepath.Join(checkFileDir, "badOperator.chk")+":3:2:",
+				"'>': GT(int):"),
+			p:        pOps,
+			fileName: "badOperator.chk",
+		},
+		{
+			ID: testhelper.MkID("bad: no such file"),
+			ExpErr: testhelper.MkExpErr(
+				"can't read the int-checker file:",
+				"no such file or directory"),
+			p:        p,
+			fileName: "nonesuch.chk",
+		},
+	}
+
+	for _, tc := range testCases {
+		vcs, err := tc.p.ParseFile(filepath.Join(checkFileDir, tc.fileName))
+		if testhelper.CheckExpErr(t, err, tc) &&
+			err == nil &&
+			testhelper.DiffInt(t, tc.IDStr(), "number of ValCk funcs",
+				len(vcs), tc.expLen) {
+			for _, v := range tc.passingVals {
+				if err := check.And(vcs...)(v); err != nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: unexpected error checking %d: %s", v, err)
+					t.Error("\t: Bad check")
+				}
+			}
+
+			for _, v := range tc.failingVals {
+				if err := check.And(vcs...)(v); err == nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: missing error checking %d", v)
+					t.Error("\t: Bad check")
+				}
+			}
+		}
+	}
+}
+
+func TestSetterFromFile(t *testing.T) {
+	value := []check.ValCk[int]{}
+	s := checksetter.Setter[int]{
+		Value:  &value,
+		Parser: checksetter.FindParserOrPanic[int](checksetter.IntCheckerName),
+	}
+
+	paramVal := "@" + filepath.Join(checkFileDir, "good.chk")
+
+	if err := s.SetWithVal("", paramVal); err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	testhelper.DiffInt(t, "setting from a file", "number of checks",
+		len(value), 3)
+	testhelper.DiffString(t, "setting from a file", "current value",
+		s.CurrentValue(), `3 checks: "`+paramVal+`"`)
+}
@@ -0,0 +1,128 @@
+package checksetter
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// CheckError records the failure of a check in a CheckList
+type CheckError struct {
+	// Index is the position of the check in the CheckList, counting from 0
+	Index int
+	// Source is the source text of the check
+	Source string
+	// Err is the error returned by the check
+	Err error
+}
+
+// Error returns the error message, annotated with the position and source
+// of the check
+func (e CheckError) Error() string {
+	return fmt.Sprintf("check %d (%s): %s", e.Index, e.Source, e.Err)
+}
+
+// Unwrap returns the error returned by the check
+func (e CheckError) Unwrap() error {
+	return e.Err
+}
+
+// CheckList holds a list of checks together with the source text of each
+// check. The Checks and Sources slices are always of the same length.
+type CheckList[T any] struct {
+	Checks  []check.ValCk[T]
+	Sources []string
+}
+
+// Len returns the number of checks in the CheckList
+func (cl CheckList[T]) Len() int {
+	return len(cl.Checks)
+}
+
+// Check applies the checks to the value in turn and returns a CheckError
+// for the first check which fails or nil if they all pass.
+func (cl CheckList[T]) Check(v T) error {
+	for i, cf := range cl.Checks {
+		if err := cf(v); err != nil {
+			return CheckError{Index: i, Source: cl.Sources[i], Err: err}
+		}
+	}
+
+	return nil
+}
+
+// CheckAll applies every check to the value and returns nil if they all
+// pass. Otherwise it returns the CheckErrors of every check which failed,
+// joined (see errors.Join) in the order of the checks.
+func (cl CheckList[T]) CheckAll(v T) error {
+	var errs []error
+
+	for i, cf := range cl.Checks {
+		if err := cf(v); err != nil {
+			errs = append(errs,
+				CheckError{Index: i, Source: cl.Sources[i], Err: err})
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// mkCheckList makes the CheckList from the elements of the list of checks
+// parsed from the source
+func (p Parser[T]) mkCheckList(src string, elts []ast.Expr,
+	fset *token.FileSet, errFset *token.FileSet,
+) (CheckList[T], error) {
+	checks, err := p.makeCheckFuncs(elts, errFset)
+	if err != nil {
+		return CheckList[T]{}, err
+	}
+
+	exprs, _, err := p.splitLocalDefs(elts)
+	if err != nil {
+		return CheckList[T]{}, err
+	}
+
+	ss := mkSrcSpans(src, fset)
+	cl := CheckList[T]{
+		Checks:  checks,
+		Sources: make([]string, 0, len(exprs)),
+	}
+
+	for _, e := range exprs {
+		text, _, _ := ss.span(e)
+		cl.Sources = append(cl.Sources, text)
+	}
+
+	return cl, nil
+}
+
+// ParseList parses the given string, as for the Parse method, and returns
+// a CheckList holding the checks and the source text of each of them.
+func (p Parser[T]) ParseList(s string) (CheckList[T], error) {
+	elts, fset, err := p.srcElts(s, "")
+	if err != nil {
+		return CheckList[T]{}, err
+	}
+
+	return p.mkCheckList(s, elts, fset, nil)
+}
+
+// ParseFileList reads the named file and parses its contents, as for the
+// ParseFile method, and returns a CheckList holding the checks and the
+// source text of each of them.
+func (p Parser[T]) ParseFileList(fileName string) (CheckList[T], error) {
+	src, err := p.readFileSrc(fileName)
+	if err != nil {
+		return CheckList[T]{}, err
+	}
+
+	elts, fset, err := p.srcElts(src, fileName)
+	if err != nil {
+		return CheckList[T]{}, err
+	}
+
+	return p.mkCheckList(src, elts, fset, fset)
+}
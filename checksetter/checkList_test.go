@@ -0,0 +1,170 @@
+package checksetter_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCheckList(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		p          *checksetter.Parser[int]
+		checks     string
+		expSources []string
+		val        int
+		expErr     string
+		expAllErr  string
+	}{
+		{
+			ID:         testhelper.MkID("all pass"),
+			p:          p,
+			checks:     "GT(0), LT(10)",
+			expSources: []string{"GT(0)", "LT(10)"},
+			val:        5,
+		},
+		{
+			ID:         testhelper.MkID("one fails"),
+			p:          p,
+			checks:     "GT(0),\n LT(10)",
+			expSources: []string{"GT(0)", "LT(10)"},
+			val:        10,
+			expErr: "check 1 (LT(10)):" +
+				" the value (10) must be less than 10",
+			expAllErr: "check 1 (LT(10)):" +
+				" the value (10) must be less than 10",
+		},
+		{
+			ID:         testhelper.MkID("several fail"),
+			p:          p,
+			checks:     "small: LT(10), GT(20), small, Or(EQ(1), EQ(2))",
+			expSources: []string{"GT(20)", "small", "Or(EQ(1), EQ(2))"},
+			val:        15,
+			expErr: "check 0 (GT(20)):" +
+				" the value (15) must be greater than 20",
+			expAllErr: "check 0 (GT(20)):" +
+				" the value (15) must be greater than 20\n" +
+				"check 1 (small):" +
+				" the value (15) must be less than 10\n" +
+				"check 2 (Or(EQ(1), EQ(2))):" +
+				" either [the value (15) must equal 1]" +
+				" or [the value (15) must equal 2]",
+		},
+		{
+			ID:         testhelper.MkID("operators"),
+			p:          p.WithOperators(),
+			checks:     ">= 1 && (< 5 || == 9), != 3",
+			expSources: []string{">= 1 && (< 5 || == 9)", "!= 3"},
+			val:        3,
+			expErr: "check 1 (!= 3):" +
+				" 3 should not be equal to 3",
+			expAllErr: "check 1 (!= 3):" +
+				" 3 should not be equal to 3",
+		},
+		{
+			ID:     testhelper.MkID("bad checks"),
+			ExpErr: testhelper.MkExpErr("can't make int-checker function"),
+			p:      p,
+			checks: "Nonesuch",
+		},
+	}
+
+	for _, tc := range testCases {
+		cl, err := tc.p.ParseList(tc.checks)
+		if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+			continue
+		}
+
+		testhelper.DiffInt(t, tc.IDStr(), "length",
+			cl.Len(), len(tc.expSources))
+		testhelper.DiffStringSlice(t, tc.IDStr(), "sources",
+			cl.Sources, tc.expSources)
+
+		errStr, allErrStr := "", ""
+		if err := cl.Check(tc.val); err != nil {
+			errStr = err.Error()
+		}
+
+		if err := cl.CheckAll(tc.val); err != nil {
+			allErrStr = err.Error()
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "first error", errStr, tc.expErr)
+		testhelper.DiffString(t, tc.IDStr(), "all errors",
+			allErrStr, tc.expAllErr)
+	}
+}
+
+func TestCheckListErrors(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	cl, err := p.ParseList("GT(0), LT(10), EQ(5)")
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	var ce checksetter.CheckError
+
+	err = cl.CheckAll(20)
+	if !errors.As(err, &ce) {
+		t.Fatal("the error should be a CheckError: ", err)
+	}
+
+	testhelper.DiffInt(t, "CheckAll", "index", ce.Index, 1)
+	testhelper.DiffString(t, "CheckAll", "source", ce.Source, "LT(10)")
+
+	if ce.Unwrap() == nil {
+		t.Error("the CheckError should wrap the error from the check")
+	}
+}
+
+func TestParseFileList(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	cl, err := p.ParseFileList(filepath.Join(checkFileDir, "good.chk"))
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	testhelper.DiffStringSlice(t, "good.chk", "sources", cl.Sources,
+		[]string{
+			"GE(1024)",
+			"LE(65535)",
+			"Not(EQ(8080),\n\t\"the HTTP port // not a comment\")",
+		})
+
+	_, err = p.ParseFileList(filepath.Join(checkFileDir, "badCheck.chk"))
+	testhelper.DiffBool(t, "badCheck.chk", "has error", err != nil, true)
+}
+
+func TestSetterCheckList(t *testing.T) {
+	var cl checksetter.CheckList[int]
+
+	s := checksetter.Setter[int]{
+		Parser:    checksetter.FindParserOrPanic[int](checksetter.IntCheckerName),
+		CheckList: &cl,
+	}
+
+	panicked, panicVal := testhelper.PanicSafe(func() { s.CheckSetter("test") })
+	if panicked {
+		t.Fatal("unexpected panic: ", panicVal)
+	}
+
+	if err := s.SetWithVal("", "GE(1), LE(9)"); err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	testhelper.DiffString(t, "Setter", "current value",
+		s.CurrentValue(), `2 checks: "GE(1), LE(9)"`)
+	testhelper.DiffStringSlice(t, "Setter", "sources",
+		cl.Sources, []string{"GE(1)", "LE(9)"})
+	testhelper.DiffString(t, "Setter", "check",
+		cl.Check(10).Error(),
+		"check 1 (LE(9)): the value (10) must be less than or equal to 9")
+}
@@ -0,0 +1,109 @@
+package checksetter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// checkCount panics if the count is not between zero and the number of
+// check funcs
+func checkCount[T any](n int, chkFuncs []check.ValCk[T]) {
+	if n < 0 || n > len(chkFuncs) {
+		panic(fmt.Sprintf("the count (%d) must be between 0 and"+
+			" the number of checks (%d)", n, len(chkFuncs)))
+	}
+}
+
+// countPassing returns the number of the check funcs that the value passes
+// and a description of the errors from those that it fails
+func countPassing[T any](v T, chkFuncs []check.ValCk[T]) (int, string) {
+	passed := 0
+	failures := []string{}
+
+	for _, cf := range chkFuncs {
+		if err := cf(v); err != nil {
+			failures = append(failures, err.Error())
+			continue
+		}
+
+		passed++
+	}
+
+	if len(failures) == 0 {
+		return passed, ""
+	}
+
+	return passed, ", failed: [" + strings.Join(failures, "] and [") + "]"
+}
+
+// valAtLeast returns a function that will check that the value passes at
+// least n of the check funcs. It panics if n is less than zero or greater
+// than the number of check funcs.
+func valAtLeast[T any](n int, chkFuncs ...check.ValCk[T]) check.ValCk[T] {
+	checkCount(n, chkFuncs)
+
+	return func(v T) error {
+		passed, failures := countPassing(v, chkFuncs)
+		if passed >= n {
+			return nil
+		}
+
+		return fmt.Errorf("the value (%v) must pass at least %d of the"+
+			" %d checks but passed %d%s",
+			v, n, len(chkFuncs), passed, failures)
+	}
+}
+
+// valAtMost returns a function that will check that the value passes at
+// most n of the check funcs. It panics if n is less than zero or greater
+// than the number of check funcs.
+func valAtMost[T any](n int, chkFuncs ...check.ValCk[T]) check.ValCk[T] {
+	checkCount(n, chkFuncs)
+
+	return func(v T) error {
+		passed, _ := countPassing(v, chkFuncs)
+		if passed <= n {
+			return nil
+		}
+
+		return fmt.Errorf("the value (%v) must pass at most %d of the"+
+			" %d checks but passed %d",
+			v, n, len(chkFuncs), passed)
+	}
+}
+
+// valExactly returns a function that will check that the value passes
+// exactly n of the check funcs. It panics if n is less than zero or greater
+// than the number of check funcs.
+func valExactly[T any](n int, chkFuncs ...check.ValCk[T]) check.ValCk[T] {
+	checkCount(n, chkFuncs)
+
+	return func(v T) error {
+		passed, failures := countPassing(v, chkFuncs)
+		if passed == n {
+			return nil
+		}
+
+		if passed > n {
+			failures = ""
+		}
+
+		return fmt.Errorf("the value (%v) must pass exactly %d of the"+
+			" %d checks but passed %d%s",
+			v, n, len(chkFuncs), passed, failures)
+	}
+}
+
+// valXor returns a function that will check that the value passes exactly
+// one of the check funcs. It panics if no check funcs are given.
+func valXor[T any](chkFuncs ...check.ValCk[T]) check.ValCk[T] {
+	return valExactly(1, chkFuncs...)
+}
+
+// valNone returns a function that will check that the value passes none of
+// the check funcs.
+func valNone[T any](chkFuncs ...check.ValCk[T]) check.ValCk[T] {
+	return valAtMost(0, chkFuncs...)
+}
@@ -0,0 +1,104 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCountingCombinators(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[int]
+	}{
+		{
+			ID:   testhelper.MkID("AtLeast"),
+			expr: "AtLeast(2, GT(0), Divides(12), IsAMultiple(5))",
+			valErrs: []valErr[int]{
+				{val: 3},
+				{val: 10},
+				{
+					val: 7,
+					expErr: "the value (7) must pass at least 2 of the" +
+						" 3 checks but passed 1, failed:" +
+						" [the value (7) must be a divisor of 12]" +
+						" and [the value (7) must be a multiple of 5]",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("AtMost"),
+			expr: "AtMost(1, GT(0), Divides(12), IsAMultiple(5))",
+			valErrs: []valErr[int]{
+				{val: -1},
+				{val: 7},
+				{
+					val: 10,
+					expErr: "the value (10) must pass at most 1 of the" +
+						" 3 checks but passed 2",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Exactly"),
+			expr: "Exactly(2, GT(0), Divides(12), IsAMultiple(5))",
+			valErrs: []valErr[int]{
+				{val: 6},
+				{
+					val: -7,
+					expErr: "the value (-7) must pass exactly 2 of the" +
+						" 3 checks but passed 0, failed:" +
+						" [the value (-7) must be greater than 0]" +
+						" and [the value (-7) must be a divisor of 12]" +
+						" and [the value (-7) must be a multiple of 5]",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Xor"),
+			expr: "Xor(LT(0), IsAMultiple(2))",
+			valErrs: []valErr[int]{
+				{val: -1},
+				{val: 2},
+				{
+					val: -2,
+					expErr: "the value (-2) must pass exactly 1 of the" +
+						" 2 checks but passed 2",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("None"),
+			expr: "None(LT(0), EQ(7))",
+			valErrs: []valErr[int]{
+				{val: 1},
+				{
+					val: 7,
+					expErr: "the value (7) must pass at most 0 of the" +
+						" 2 checks but passed 1",
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: count too big"),
+			ExpErr: testhelper.MkExpErr("AtLeast(count, ..., int-checker):",
+				"the count (3) must be between 0 and the number of checks (2)"),
+			expr: "AtLeast(3, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("bad: no checks"),
+			ExpErr: testhelper.MkExpErr("Xor(..., int-checker):",
+				"the count (1) must be between 0 and the number of checks (0)"),
+			expr: "Xor()",
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+}
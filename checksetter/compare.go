@@ -0,0 +1,273 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"math/big"
+	"reflect"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// maxCounterExamples is the maximum number of values given in each list of
+// counter-examples in a Comparison
+const maxCounterExamples = 3
+
+// Relation describes how a list of checks relates to another
+type Relation int
+
+// These are the ways that a new list of checks can relate to an old one
+const (
+	// Equivalent means that the same values pass both lists of checks
+	Equivalent Relation = iota
+	// Stricter means that every value which passes the new checks also
+	// passes the old checks but some values which pass the old checks
+	// fail the new checks
+	Stricter
+	// Looser means that every value which passes the old checks also
+	// passes the new checks but some values which fail the old checks
+	// pass the new checks
+	Looser
+	// Incomparable means that some values pass only the old checks and
+	// some pass only the new checks
+	Incomparable
+)
+
+// String returns the name of the Relation
+func (r Relation) String() string {
+	switch r {
+	case Equivalent:
+		return "equivalent"
+	case Stricter:
+		return "stricter"
+	case Looser:
+		return "looser"
+	case Incomparable:
+		return "incomparable"
+	}
+
+	return fmt.Sprintf("Relation(%d)", int(r))
+}
+
+// Comparison records the result of comparing a new list of checks with an
+// old one
+type Comparison[T any] struct {
+	// Relation describes how the new checks relate to the old checks
+	Relation Relation
+
+	// Exact is set if the Relation has been proved. It is false if the
+	// Relation has been found by trying sample values in which case the
+	// checks may differ for values which were not tried; if so the
+	// Relation may be wrong but any counter-examples are still valid.
+	Exact bool
+
+	// Rejected holds values which pass the old checks but fail the new
+	// checks
+	Rejected []T
+	// Admitted holds values which fail the old checks but pass the new
+	// checks
+	Admitted []T
+}
+
+// ordValTo converts the value to a T. The conversion depends on the kind
+// of T so that named types, such as time.Duration, can be converted. It
+// returns false if the value cannot be represented as a T.
+func ordValTo[T any](v ordVal) (T, bool) {
+	var zero T
+
+	rv := reflect.New(reflect.TypeFor[T]()).Elem()
+
+	switch rv.Kind() {
+	case reflect.String:
+		if v.num != nil {
+			return zero, false
+		}
+
+		rv.SetString(v.str)
+	case reflect.Float64:
+		if v.num == nil {
+			return zero, false
+		}
+
+		f, _ := v.num.Float64()
+		rv.SetFloat(f)
+	case reflect.Int, reflect.Int64:
+		if v.num == nil || !v.num.IsInt() || !v.num.Num().IsInt64() ||
+			rv.OverflowInt(v.num.Num().Int64()) {
+			return zero, false
+		}
+
+		rv.SetInt(v.num.Num().Int64())
+	default:
+		return zero, false
+	}
+
+	return rv.Interface().(T), true //nolint:forcetypeassert
+}
+
+// rangePoints returns a value from each interval in the range
+func rangePoints(r valRange) []ordVal {
+	points := make([]ordVal, 0, len(r.ivs))
+
+	for _, iv := range r.ivs {
+		lo, hi := iv.lo, iv.hi
+		isStr := (!lo.unbounded && lo.val.num == nil) ||
+			(!hi.unbounded && hi.val.num == nil)
+
+		switch {
+		case !lo.unbounded && !lo.open:
+			points = append(points, lo.val)
+		case !hi.unbounded && !hi.open:
+			points = append(points, hi.val)
+		case lo.unbounded && hi.unbounded:
+			// the kind is unknown so give both a number and a string
+			points = append(points, ordVal{num: new(big.Rat)}, ordVal{})
+		case isStr:
+			// the interval has an open endpoint
+			if !lo.unbounded {
+				points = append(points, ordVal{str: lo.val.str + "\x00"})
+			} else if hi.val.str != "" {
+				points = append(points, ordVal{})
+			}
+		case lo.unbounded:
+			points = append(points, hi.val.add(-1))
+		case hi.unbounded:
+			points = append(points, lo.val.add(1))
+		default:
+			mid := new(big.Rat).Add(lo.val.num, hi.val.num)
+			points = append(points,
+				ordVal{num: mid.Mul(mid, big.NewRat(1, 2))}) //nolint:mnd
+		}
+	}
+
+	return points
+}
+
+// parseForCompare parses the list of checks and returns the elements of the
+// list and a check func combining them
+func (p Parser[T]) parseForCompare(s, desc string) (
+	[]ast.Expr, check.ValCk[T], error,
+) {
+	elts, err := p.listElts(s)
+	if err == nil {
+		var vcs []check.ValCk[T]
+
+		vcs, err = p.makeCheckFuncs(elts, nil)
+		if err == nil {
+			return elts, check.And(vcs...), nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("the %s checks: %w", desc, err)
+}
+
+// Compare parses the old and new lists of checks, as for the Parser's
+// Parse method, and reports whether the new checks are Equivalent to,
+// Stricter than, Looser than or Incomparable with the old checks, together
+// with counter-examples: values which pass only one of them.
+//
+// Where the checks are comparisons, as described for the Parser's Analyse
+// method, the Relation is decided exactly. Otherwise it is found by trying
+// the checks against sample values, as generated by the Examples method,
+// and the Comparison is not Exact. Comparisons can be made for the same
+// types of value as for the Examples method; any other type gives an
+// error.
+//
+// For instance, for the int-checker, comparing 'GT(0), LT(100)' with
+// 'Between(1, 50)' gives a Relation of Stricter and the Rejected values
+// include 51.
+func (p Parser[T]) Compare(oldChecks, newChecks string) (
+	Comparison[T], error,
+) {
+	var cmp Comparison[T]
+
+	oldElts, oldCF, err := p.parseForCompare(oldChecks, "old")
+	if err != nil {
+		return cmp, err
+	}
+
+	newElts, newCF, err := p.parseForCompare(newChecks, "new")
+	if err != nil {
+		return cmp, err
+	}
+
+	var (
+		cands                        []T
+		newImpliesOld, oldImpliesNew bool
+	)
+
+	oldA, _, oldRI, err := p.analyseList(oldElts, nil)
+	if err != nil {
+		return cmp, err
+	}
+
+	_, _, newRI, err := p.analyseList(newElts, nil)
+	if err != nil {
+		return cmp, err
+	}
+
+	if oldA != nil {
+		newImpliesOld = newRI.implies(oldRI)
+		oldImpliesNew = oldRI.implies(newRI)
+
+		for _, r := range []valRange{
+			oldRI.must.intersect(newRI.may.complement()),
+			newRI.must.intersect(oldRI.may.complement()),
+		} {
+			for _, pt := range rangePoints(r) {
+				if v, ok := ordValTo[T](pt); ok {
+					cands = append(cands, v)
+				}
+			}
+		}
+	}
+
+	for _, elts := range [][]ast.Expr{oldElts, newElts} {
+		ec, err := p.exampleCands(elts)
+		if err != nil {
+			return cmp, err
+		}
+
+		cands = append(cands, ec...)
+	}
+
+	seen := map[string]bool{}
+
+	for _, c := range cands {
+		key := fmt.Sprintf("%#v", c)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		oldOK, okOld := tryCheck(oldCF, c)
+		newOK, okNew := tryCheck(newCF, c)
+
+		if !okOld || !okNew {
+			continue
+		}
+
+		switch {
+		case oldOK && !newOK && len(cmp.Rejected) < maxCounterExamples:
+			cmp.Rejected = append(cmp.Rejected, c)
+		case newOK && !oldOK && len(cmp.Admitted) < maxCounterExamples:
+			cmp.Admitted = append(cmp.Admitted, c)
+		}
+	}
+
+	rejects, admits := len(cmp.Rejected) > 0, len(cmp.Admitted) > 0
+
+	switch {
+	case rejects && admits:
+		cmp.Relation = Incomparable
+	case rejects:
+		cmp.Relation = Stricter
+	case admits:
+		cmp.Relation = Looser
+	}
+
+	cmp.Exact = (newImpliesOld || admits) && (oldImpliesNew || rejects)
+
+	return cmp, nil
+}
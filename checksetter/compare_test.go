@@ -0,0 +1,196 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestCompareInt(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		oldChecks   string
+		newChecks   string
+		expRel      checksetter.Relation
+		expExact    bool
+		expRejected []int
+		expAdmitted []int
+	}{
+		{
+			ID:        testhelper.MkID("equivalent"),
+			oldChecks: "GT(0), LT(100)",
+			newChecks: "Between(1, 99)",
+			expRel:    checksetter.Equivalent,
+			expExact:  true,
+		},
+		{
+			ID:          testhelper.MkID("stricter"),
+			oldChecks:   "GT(0), LT(100)",
+			newChecks:   "Between(1, 50)",
+			expRel:      checksetter.Stricter,
+			expExact:    true,
+			expRejected: []int{51, 98, 99},
+		},
+		{
+			ID:          testhelper.MkID("looser"),
+			oldChecks:   "Between(1, 50)",
+			newChecks:   "GE(1)",
+			expRel:      checksetter.Looser,
+			expExact:    true,
+			expAdmitted: []int{51, 100, 150},
+		},
+		{
+			ID:          testhelper.MkID("incomparable"),
+			oldChecks:   "LT(10)",
+			newChecks:   "GT(5)",
+			expRel:      checksetter.Incomparable,
+			expExact:    true,
+			expRejected: []int{5, -10, 0},
+			expAdmitted: []int{10, 11, 20},
+		},
+		{
+			ID:        testhelper.MkID("equivalent by sampling"),
+			oldChecks: "Divides(60)",
+			newChecks: "Or(Divides(60))",
+			expRel:    checksetter.Equivalent,
+			expExact:  false,
+		},
+		{
+			ID:          testhelper.MkID("stricter by sampling"),
+			oldChecks:   "Divides(60)",
+			newChecks:   "Divides(30)",
+			expRel:      checksetter.Stricter,
+			expExact:    false,
+			expRejected: []int{60, -60},
+		},
+		{
+			ID:          testhelper.MkID("exact with a counter-example"),
+			oldChecks:   "GT(0)",
+			newChecks:   "GT(0), Divides(60)",
+			expRel:      checksetter.Stricter,
+			expExact:    true,
+			expRejected: []int{100, 1000, 59},
+		},
+		{
+			ID: testhelper.MkID("bad old checks"),
+			ExpErr: testhelper.MkExpErr("the old checks: ",
+				"can't make int-checker function"),
+			oldChecks: "Nonesuch",
+			newChecks: "OK",
+		},
+		{
+			ID:        testhelper.MkID("bad new checks"),
+			ExpErr:    testhelper.MkExpErr("the new checks: "),
+			oldChecks: "OK",
+			newChecks: "GT(",
+		},
+	}
+
+	for _, tc := range testCases {
+		cmp, err := p.Compare(tc.oldChecks, tc.newChecks)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "relation",
+				cmp.Relation.String(), tc.expRel.String())
+			testhelper.DiffBool(t, tc.IDStr(), "exact",
+				cmp.Exact, tc.expExact)
+			testhelper.DiffSlice(t, tc.IDStr(), "rejected",
+				cmp.Rejected, tc.expRejected)
+			testhelper.DiffSlice(t, tc.IDStr(), "admitted",
+				cmp.Admitted, tc.expAdmitted)
+		}
+	}
+}
+
+func TestCompareString(t *testing.T) {
+	p := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		oldChecks string
+		newChecks string
+		expRel    checksetter.Relation
+		expExact  bool
+	}{
+		{
+			ID:        testhelper.MkID("ordered strings"),
+			oldChecks: `GE("a"), LT("n")`,
+			newChecks: `GE("a"), LT("z")`,
+			expRel:    checksetter.Looser,
+			expExact:  true,
+		},
+		{
+			ID:        testhelper.MkID("prefixes"),
+			oldChecks: `HasPrefix("ab")`,
+			newChecks: `HasPrefix("a")`,
+			expRel:    checksetter.Looser,
+			expExact:  false,
+		},
+		{
+			ID:        testhelper.MkID("equivalent sets"),
+			oldChecks: `OneOf("x", "y")`,
+			newChecks: `Or(EQ("y"), EQ("x"))`,
+			expRel:    checksetter.Equivalent,
+			expExact:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		cmp, err := p.Compare(tc.oldChecks, tc.newChecks)
+		if err != nil {
+			t.Log(tc.IDStr())
+			t.Errorf("\t: unexpected error: %s", err)
+
+			continue
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "relation",
+			cmp.Relation.String(), tc.expRel.String())
+		testhelper.DiffBool(t, tc.IDStr(), "exact", cmp.Exact, tc.expExact)
+	}
+}
+
+func TestCompareNamedType(t *testing.T) {
+	const checkerName = "TestCompareNamedType"
+
+	p, err := checksetter.MakeParser(checkerName,
+		map[string]checksetter.MakerInfo[testPort]{
+			"GT": checksetter.Maker1(
+				map[string]func(int) check.ValCk[testPort]{
+					"GT": func(i int) check.ValCk[testPort] {
+						return check.ValGT[testPort](testPort(i))
+					},
+				},
+				checksetter.IntArg),
+		})
+	if err != nil {
+		t.Fatal("couldn't make the parser: " + err.Error())
+	}
+
+	id := testhelper.MkID("named int")
+
+	cmp, err := p.Compare("GT(0)", "GT(10)")
+	if err != nil {
+		t.Fatal(id.IDStr(), ": unexpected error: ", err)
+	}
+
+	testhelper.DiffString(t, id.IDStr(), "relation",
+		cmp.Relation.String(), checksetter.Stricter.String())
+	testhelper.DiffBool(t, id.IDStr(), "exact", cmp.Exact, true)
+
+	for _, v := range cmp.Rejected {
+		if v <= 0 || v > 10 {
+			t.Log(id.IDStr())
+			t.Errorf("\t: %d should not be rejected", v)
+		}
+	}
+
+	if len(cmp.Rejected) == 0 {
+		t.Log(id.IDStr())
+		t.Error("\t: there should be some rejected values")
+	}
+}
@@ -0,0 +1,277 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Completion describes what can be typed at the cursor position in a
+// partially typed list of checks.
+type Completion struct {
+	// ArgKind is the kind of value expected at the cursor, as given in
+	// the Args of the function being called. Where a check is expected,
+	// including at the top level of the list, it is the name of the
+	// family of checks, for instance "int-checker". It is empty if
+	// nothing more can be given, for instance if the function being
+	// called has already been given all its arguments.
+	ArgKind string
+	// IsChecker is set if the ArgKind is the name of a family of checks
+	IsChecker bool
+
+	// Prefix is the part of the name being typed which comes before the
+	// cursor. It starts at the byte offset given by Start. A completion
+	// should replace the Prefix.
+	Prefix string
+	Start  int
+
+	// Candidates holds the names which start with the Prefix and can be
+	// given at the cursor, in sorted order. Where a check is expected
+	// these are the functions and named checks of the family, including
+	// those named in the list before the check being typed; otherwise
+	// they are the names of the constants available to the function
+	// being called. There are none if the cursor is within, or just
+	// after, a literal value.
+	Candidates []string
+}
+
+// completionFrame records the state of a call (or a parenthesised group of
+// checks) which is open at the cursor
+type completionFrame struct {
+	family string
+	args   []string
+	argIdx int
+}
+
+// kind returns the kind of argument expected next in the frame
+func (f completionFrame) kind() (string, bool) {
+	return argKindAt(f.args, f.argIdx)
+}
+
+// familyParser returns the Parser for the named family of checks
+func (p Parser[T]) familyParser(family string) (anyParser, bool) {
+	if family == p.checkerName {
+		return p, true
+	}
+
+	fp, ok := parserRegister[family]
+
+	return fp, ok
+}
+
+// callFrame returns the frame for a call of the named function from the
+// given family of checks. It returns false if the function is unknown.
+func (p Parser[T]) callFrame(family, name string) (completionFrame, bool) {
+	fp, ok := p.familyParser(family)
+	if !ok {
+		return completionFrame{}, false
+	}
+
+	args, err := fp.Args(name)
+	if err != nil {
+		return completionFrame{}, false
+	}
+
+	return completionFrame{family: family, args: args}, true
+}
+
+// groupFrame returns a frame in which every argument is of the given kind.
+// It is used for the list of checks and for parenthesised checks written
+// with operators.
+func groupFrame(kind string) completionFrame {
+	return completionFrame{
+		family: kind,
+		args:   []string{variadicArgMarker, kind},
+	}
+}
+
+// candidates returns the sorted names from the lists which start with the
+// prefix
+func candidates(prefix string, names ...[]string) []string {
+	var c []string
+
+	for _, n := range names {
+		for _, name := range n {
+			if strings.HasPrefix(name, prefix) {
+				c = append(c, name)
+			}
+		}
+	}
+
+	slices.Sort(c)
+
+	return slices.Compact(c)
+}
+
+// Complete returns a description of what can be typed at the cursor in the
+// partially typed list of checks. The cursor is the byte offset into the
+// string and only the text before it is used. The nesting of the checks
+// is followed so that, for instance, for the string-checker the
+// candidates after 'Length(' are the functions of the int-checker, and the
+// kind of argument expected is reported even where there are no names to
+// complete. The names of checks defined earlier in the list, as
+// 'name: check', are offered wherever a check of this Parser's family is
+// expected. It returns an error if the cursor is not within the string.
+func (p Parser[T]) Complete(partial string, cursor int) (Completion, error) {
+	if cursor < 0 || cursor > len(partial) {
+		return Completion{},
+			fmt.Errorf("the cursor (%d) is not within the checks (length: %d)",
+				cursor, len(partial))
+	}
+
+	src := partial[:cursor]
+
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+
+	var sc scanner.Scanner
+
+	sc.Init(file, []byte(src), func(token.Position, string) {}, 0)
+
+	type tok struct {
+		offset int
+		tok    token.Token
+		lit    string
+	}
+
+	var toks []tok
+
+	for {
+		pos, t, lit := sc.Scan()
+		if t == token.EOF {
+			break
+		}
+
+		if t == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+
+		toks = append(toks, tok{offset: file.Offset(pos), tok: t, lit: lit})
+	}
+
+	c := Completion{Start: cursor}
+	inLiteral := false
+
+	if n := len(toks); n > 0 && toks[n-1].offset+len(toks[n-1].lit) == cursor {
+		switch toks[n-1].tok {
+		case token.IDENT:
+			c.Prefix, c.Start = toks[n-1].lit, toks[n-1].offset
+			toks = toks[:n-1]
+		case token.INT, token.FLOAT, token.STRING, token.CHAR:
+			// the cursor is within, or just after, a literal value
+			inLiteral = true
+			toks = toks[:n-1]
+		}
+	}
+
+	stack := []completionFrame{groupFrame(p.checkerName)}
+	cmpMaker := ""
+
+	// the names of the checks defined in the list (as 'name: check')
+	// before the check being typed
+	var localNames []string
+
+	defName := ""
+
+	for i, t := range toks {
+		top := &stack[len(stack)-1]
+		prevCmp := cmpMaker
+		cmpMaker = ""
+
+		switch t.tok {
+		case token.LPAREN:
+			kind, _ := top.kind()
+
+			if i > 0 && toks[i-1].tok == token.IDENT {
+				if f, ok := p.callFrame(kind, toks[i-1].lit); ok {
+					stack = append(stack, f)
+					continue
+				}
+
+				// an unknown function: nothing can be offered inside it
+				stack = append(stack, completionFrame{family: kind})
+
+				continue
+			}
+
+			stack = append(stack, groupFrame(kind))
+		case token.RPAREN:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case token.COMMA:
+			top.argIdx++
+
+			if len(stack) == 1 && defName != "" {
+				localNames = append(localNames, defName)
+				defName = ""
+			}
+		case token.COLON:
+			if len(stack) == 1 && i > 0 && toks[i-1].tok == token.IDENT &&
+				(i == 1 || toks[i-2].tok == token.COMMA) {
+				defName = toks[i-1].lit
+			}
+		default:
+			if name, ok := comparisonMakers[t.tok]; ok && p.operators {
+				cmpMaker = name
+			} else if t.tok == token.SUB {
+				// a negative value may follow a comparison operator
+				cmpMaker = prevCmp
+			}
+		}
+	}
+
+	top := stack[len(stack)-1]
+
+	kind, ok := top.kind()
+	if !ok {
+		return c, nil
+	}
+
+	family := top.family
+
+	if cmpMaker != "" {
+		f, ok := p.callFrame(kind, cmpMaker)
+		if !ok {
+			return c, nil
+		}
+
+		family = kind
+
+		if kind, ok = f.kind(); !ok {
+			return c, nil
+		}
+	}
+
+	c.ArgKind = kind
+
+	fp, isChecker := p.familyParser(kind)
+	c.IsChecker = isChecker
+
+	if inLiteral {
+		// no names can be given within a literal value
+		return c, nil
+	}
+
+	if isChecker {
+		if kind != p.checkerName {
+			localNames = nil
+		}
+
+		c.Candidates = candidates(c.Prefix,
+			fp.Makers(), slices.Collect(maps.Keys(fp.NamedChecks())),
+			localNames)
+
+		return c, nil
+	}
+
+	if fp, ok = p.familyParser(family); ok {
+		c.Candidates = candidates(c.Prefix,
+			slices.Collect(maps.Keys(fp.Constants())))
+	}
+
+	return c, nil
+}
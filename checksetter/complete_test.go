@@ -0,0 +1,250 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// completionParser is the subset of the Parser methods needed to test
+// completions of checks of any type
+type completionParser interface {
+	Complete(partial string, cursor int) (checksetter.Completion, error)
+}
+
+func TestComplete(t *testing.T) {
+	strP := checksetter.FindParserOrPanic[string](
+		checksetter.StringCheckerName)
+	slcP := checksetter.FindParserOrPanic[[]string](
+		checksetter.StringSliceCheckerName)
+	intP := checksetter.FindParserOrPanic[int](
+		checksetter.IntCheckerName).WithOperators()
+	strOpsP := strP.WithOperators()
+
+	testP, _ := mkNamedCheckTestParser(t, "TestComplete")
+	if err := testP.AddNamedCheck("small", "LE(9)"); err != nil {
+		t.Fatal("couldn't add the named check: " + err.Error())
+	}
+
+	if err := testP.AddConstant("MaxWorkers", 32); err != nil {
+		t.Fatal("couldn't add the constant: " + err.Error())
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		p             completionParser
+		partial       string
+		cursor        int
+		expArgKind    string
+		expIsChecker  bool
+		expPrefix     string
+		expStart      int
+		expCandidates []string
+	}{
+		{
+			ID:            testhelper.MkID("top level prefix"),
+			p:             strP,
+			partial:       "Has",
+			cursor:        3,
+			expArgKind:    checksetter.StringCheckerName,
+			expIsChecker:  true,
+			expPrefix:     "Has",
+			expCandidates: []string{"HasPrefix", "HasSuffix"},
+		},
+		{
+			ID:            testhelper.MkID("a check named in the list"),
+			p:             slcP,
+			partial:       "p: Length(GT(2)), And(p",
+			cursor:        23,
+			expArgKind:    checksetter.StringSliceCheckerName,
+			expIsChecker:  true,
+			expPrefix:     "p",
+			expStart:      22,
+			expCandidates: []string{"p"},
+		},
+		{
+			ID:           testhelper.MkID("a check named in the list: other family"),
+			p:            slcP,
+			partial:      "p: Length(GT(2)), SliceAll(p",
+			cursor:       28,
+			expArgKind:   checksetter.StringCheckerName,
+			expIsChecker: true,
+			expPrefix:    "p",
+			expStart:     27,
+		},
+		{
+			ID:           testhelper.MkID("the check being named"),
+			p:            slcP,
+			partial:      "q: And(q",
+			cursor:       8,
+			expArgKind:   checksetter.StringSliceCheckerName,
+			expIsChecker: true,
+			expPrefix:    "q",
+			expStart:     7,
+		},
+		{
+			ID:            testhelper.MkID("nested family"),
+			p:             strP,
+			partial:       "OK, Length(G",
+			cursor:        12,
+			expArgKind:    checksetter.IntCheckerName,
+			expIsChecker:  true,
+			expPrefix:     "G",
+			expStart:      11,
+			expCandidates: []string{"GE", "GT"},
+		},
+		{
+			ID:            testhelper.MkID("cursor within the checks"),
+			p:             strP,
+			partial:       "Length(GT(3)), HasPrefix(\"x\")",
+			cursor:        9,
+			expArgKind:    checksetter.IntCheckerName,
+			expIsChecker:  true,
+			expPrefix:     "GT",
+			expStart:      7,
+			expCandidates: []string{"GT"},
+		},
+		{
+			ID:           testhelper.MkID("after a closed call"),
+			p:            strP,
+			partial:      "Not(Length(GT(3)), ",
+			cursor:       19,
+			expArgKind:   "string",
+			expIsChecker: false,
+			expStart:     19,
+		},
+		{
+			ID:            testhelper.MkID("slice of strings"),
+			p:             slcP,
+			partial:       "SliceAll(IsU",
+			cursor:        12,
+			expArgKind:    checksetter.StringCheckerName,
+			expIsChecker:  true,
+			expPrefix:     "IsU",
+			expStart:      9,
+			expCandidates: []string{"IsURL", "IsUpper"},
+		},
+		{
+			ID:         testhelper.MkID("a regexp argument"),
+			p:          strP,
+			partial:    "MatchesPattern(",
+			cursor:     15,
+			expArgKind: "regexp",
+			expStart:   15,
+		},
+		{
+			ID:       testhelper.MkID("too many arguments"),
+			p:        strP,
+			partial:  "HasPrefix(\"a\", ",
+			cursor:   15,
+			expStart: 15,
+		},
+		{
+			ID:       testhelper.MkID("unknown function"),
+			p:        strP,
+			partial:  "Nonesuch(",
+			cursor:   9,
+			expStart: 9,
+		},
+		{
+			ID:         testhelper.MkID("after a comparison operator"),
+			p:          intP,
+			partial:    ">= -",
+			cursor:     4,
+			expArgKind: "int",
+			expStart:   4,
+		},
+		{
+			ID:           testhelper.MkID("an unfinished string argument"),
+			p:            strP,
+			partial:      `Length("abc`,
+			cursor:       11,
+			expArgKind:   checksetter.IntCheckerName,
+			expIsChecker: true,
+			expStart:     11,
+		},
+		{
+			ID:           testhelper.MkID("an unfinished string, operators"),
+			p:            strOpsP,
+			partial:      `Length("abc`,
+			cursor:       11,
+			expArgKind:   checksetter.IntCheckerName,
+			expIsChecker: true,
+			expStart:     11,
+		},
+		{
+			ID:         testhelper.MkID("a value after a comparison operator"),
+			p:          strOpsP,
+			partial:    `HasSuffix("x") && == "ab`,
+			cursor:     24,
+			expArgKind: "string",
+			expStart:   24,
+		},
+		{
+			ID:         testhelper.MkID("a number after a comparison operator"),
+			p:          intP,
+			partial:    "> 3 && <= -1",
+			cursor:     12,
+			expArgKind: "int",
+			expStart:   12,
+		},
+		{
+			ID:            testhelper.MkID("after a logical operator"),
+			p:             intP,
+			partial:       "> 3 && (Di",
+			cursor:        10,
+			expArgKind:    checksetter.IntCheckerName,
+			expIsChecker:  true,
+			expPrefix:     "Di",
+			expStart:      8,
+			expCandidates: []string{"Divides"},
+		},
+		{
+			ID:           testhelper.MkID("named checks"),
+			p:            testP,
+			partial:      "And(OK, ",
+			cursor:       8,
+			expArgKind:   "TestComplete",
+			expIsChecker: true,
+			expStart:     8,
+			expCandidates: []string{
+				"And", "EQ", "GE", "LE", "Not", "OK", "small",
+			},
+		},
+		{
+			ID:            testhelper.MkID("constants"),
+			p:             testP,
+			partial:       "GE(Max",
+			cursor:        6,
+			expArgKind:    "int",
+			expPrefix:     "Max",
+			expStart:      3,
+			expCandidates: []string{"MaxWorkers"},
+		},
+		{
+			ID: testhelper.MkID("bad cursor"),
+			ExpErr: testhelper.MkExpErr(
+				"the cursor (4) is not within the checks"),
+			p:       strP,
+			partial: "Has",
+			cursor:  4,
+		},
+	}
+
+	for _, tc := range testCases {
+		c, err := tc.p.Complete(tc.partial, tc.cursor)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			testhelper.DiffString(t, tc.IDStr(), "arg kind",
+				c.ArgKind, tc.expArgKind)
+			testhelper.DiffBool(t, tc.IDStr(), "is checker",
+				c.IsChecker, tc.expIsChecker)
+			testhelper.DiffString(t, tc.IDStr(), "prefix",
+				c.Prefix, tc.expPrefix)
+			testhelper.DiffInt(t, tc.IDStr(), "start", c.Start, tc.expStart)
+			testhelper.DiffStringSlice(t, tc.IDStr(), "candidates",
+				c.Candidates, tc.expCandidates)
+		}
+	}
+}
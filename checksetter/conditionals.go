@@ -0,0 +1,64 @@
+package checksetter
+
+import (
+	"fmt"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// valIf returns a function that will check that, if the value passes the
+// condition, it also passes the then check. A value which does not pass the
+// condition is accepted.
+func valIf[T any](cond, then check.ValCk[T]) check.ValCk[T] {
+	return func(v T) error {
+		if cond(v) != nil {
+			return nil
+		}
+
+		if err := then(v); err != nil {
+			return fmt.Errorf("the condition was met, so: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// valIfElse returns a function that will check that, if the value passes
+// the condition, it also passes the then check and that, otherwise, it
+// passes the else check.
+func valIfElse[T any](cond, then, els check.ValCk[T]) check.ValCk[T] {
+	return func(v T) error {
+		condErr := cond(v)
+		if condErr == nil {
+			if err := then(v); err != nil {
+				return fmt.Errorf("the condition was met, so: %w", err)
+			}
+
+			return nil
+		}
+
+		if err := els(v); err != nil {
+			return fmt.Errorf("the condition was not met (%s), so: %w",
+				condErr, err)
+		}
+
+		return nil
+	}
+}
+
+// valImplies returns a function that will check that, if the value passes
+// the first check, it also passes the second.
+func valImplies[T any](a, b check.ValCk[T]) check.ValCk[T] {
+	return func(v T) error {
+		if a(v) != nil {
+			return nil
+		}
+
+		if err := b(v); err != nil {
+			return fmt.Errorf("%v passes the first check so it must"+
+				" pass the second: %w", v, err)
+		}
+
+		return nil
+	}
+}
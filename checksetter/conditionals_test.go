@@ -0,0 +1,105 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestConditionals(t *testing.T) {
+	ip := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+	sp := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr       string
+		isStr      bool
+		valErrs    []valErr[int]
+		strValErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("If"),
+			expr: "If(GT(100), IsAMultiple(10))",
+			valErrs: []valErr[int]{
+				{val: 7},
+				{val: 110},
+				{
+					val: 115,
+					expErr: "the condition was met, so:" +
+						" the value (115) must be a multiple of 10",
+				},
+			},
+		},
+		{
+			ID:    testhelper.MkID("If, string"),
+			expr:  `If(HasPrefix("s3://"), MatchesPattern("^s3://[a-z]+/", "a bucket"))`,
+			isStr: true,
+			strValErrs: []valErr[string]{
+				{val: "/tmp/x"},
+				{val: "s3://bucket/x"},
+				{
+					val: "s3://",
+					expErr: "the condition was met, so:" +
+						` "s3://" should be: a bucket`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IfElse"),
+			expr: "IfElse(GT(100), IsAMultiple(10), LT(50))",
+			valErrs: []valErr[int]{
+				{val: 49},
+				{val: 110},
+				{
+					val: 115,
+					expErr: "the condition was met, so:" +
+						" the value (115) must be a multiple of 10",
+				},
+				{
+					val: 50,
+					expErr: "the condition was not met" +
+						" (the value (50) must be greater than 100), so:" +
+						" the value (50) must be less than 50",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Implies"),
+			expr: "Implies(EQ(0), OK)",
+			valErrs: []valErr[int]{
+				{val: 0},
+				{val: 1},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Implies, failing"),
+			expr: "Implies(IsAMultiple(4), IsAMultiple(8))",
+			valErrs: []valErr[int]{
+				{val: 3},
+				{val: 8},
+				{
+					val: 4,
+					expErr: "4 passes the first check so it must pass" +
+						" the second: the value (4) must be a multiple of 8",
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: IfElse, missing else"),
+			ExpErr: testhelper.MkExpErr(
+				"IfElse(int-checker, int-checker, int-checker):",
+				"the call has 2 arguments, it should have 3"),
+			expr: "IfElse(LT(0), OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.isStr {
+			testValErrs(t, tc, sp, tc.expr, tc.strValErrs)
+		} else {
+			testValErrs(t, tc, ip, tc.expr, tc.valErrs)
+		}
+	}
+}
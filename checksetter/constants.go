@@ -0,0 +1,208 @@
+package checksetter
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// unknownConstError is the error returned when an identifier is given where
+// a literal value is expected and it is not the name of a constant
+type unknownConstError struct {
+	name string
+}
+
+// Error returns the error message
+func (e unknownConstError) Error() string {
+	return fmt.Sprintf("unknown constant: %q", e.name)
+}
+
+// AddConstant registers a named constant with the Parser. The name can then
+// be used in place of a literal value in the arguments of any of this
+// Parser's functions. The value must be an int, int64, float64 or string
+// and it is substituted as if the literal value had been given, so the
+// usual type checks still apply. The name of a numeric constant can be
+// preceded by a '-' to give the negated value. Note that the constant is
+// only available to the functions of this Parser's family; to use it in a
+// nested check of another family it must be added to that family's Parser.
+//
+// It will return an error if the name is already in use or the value is of
+// an unsupported type.
+func (p Parser[T]) AddConstant(name string, value any) error {
+	if !token.IsIdentifier(name) {
+		return fmt.Errorf("bad name for a constant: %q"+
+			" (it must be a valid identifier)", name)
+	}
+
+	if _, ok := p.makers[name]; ok {
+		return fmt.Errorf("bad name for a constant: %q"+
+			" (it is already the name of a %s function)",
+			name, p.checkerName)
+	}
+
+	if _, ok := p.namedChecks[name]; ok {
+		return fmt.Errorf("bad name for a constant: %q"+
+			" (it is already a named check)", name)
+	}
+
+	if _, ok := p.constants[name]; ok {
+		return fmt.Errorf("bad name for a constant: %q"+
+			" (it is already a constant)", name)
+	}
+
+	switch v := value.(type) {
+	case int, int64, float64:
+	case string:
+		if strings.HasPrefix(v, `"`) || strings.HasSuffix(v, `"`) {
+			return fmt.Errorf("bad value for the constant %q:"+
+				" a string value cannot start or end with '\"'", name)
+		}
+	default:
+		return fmt.Errorf("bad value for the constant %q:"+
+			" unsupported type: %T", name, value)
+	}
+
+	p.constants[name] = value
+
+	return nil
+}
+
+// Constants returns a map of the names of the constants to their values.
+//
+// This can be used to construct the Allowed Values message for a setter.
+func (p Parser[T]) Constants() map[string]any {
+	return maps.Clone(p.constants)
+}
+
+// constantLit returns a BasicLit representing the constant value as if it
+// had been given in the expression being parsed at the given position
+func constantLit(value any, pos token.Pos) *ast.BasicLit {
+	lit := &ast.BasicLit{ValuePos: pos}
+
+	switch v := value.(type) {
+	case int:
+		lit.Kind, lit.Value = token.INT, strconv.Itoa(v)
+	case int64:
+		lit.Kind, lit.Value = token.INT, strconv.FormatInt(v, 10)
+	case float64:
+		lit.Kind, lit.Value = token.FLOAT, strconv.FormatFloat(v, 'g', -1, 64)
+	case string:
+		lit.Kind, lit.Value = token.STRING, `"`+v+`"`
+	}
+
+	return lit
+}
+
+// constantArg returns the value of the constant named by the argument. The
+// name of a numeric constant may be preceded by a '-' in which case the
+// negated value is returned. It returns false if the argument does not
+// name a constant and an error if the negated value cannot be represented.
+func (p Parser[T]) constantArg(arg ast.Expr) (any, bool, error) {
+	neg := false
+	if u, ok := arg.(*ast.UnaryExpr); ok && u.Op == token.SUB {
+		neg, arg = true, u.X
+	}
+
+	id, ok := arg.(*ast.Ident)
+	if !ok {
+		return nil, false, nil
+	}
+
+	value, ok := p.constants[id.Name]
+	if !ok || !neg {
+		return value, ok, nil
+	}
+
+	switch v := value.(type) {
+	case int:
+		if v == math.MinInt {
+			return nil, true,
+				fmt.Errorf("-%s is too big for an int", id.Name)
+		}
+
+		return -v, true, nil
+	case int64:
+		if v == math.MinInt64 {
+			return nil, true,
+				fmt.Errorf("-%s is too big for an int64", id.Name)
+		}
+
+		return -v, true, nil
+	case float64:
+		return -v, true, nil
+	}
+
+	return nil, false, nil
+}
+
+// substituteConstants returns the CallExpr with any identifiers naming
+// constants, or their negation, replaced by the corresponding literal
+// values. Only those arguments where a nested check is not expected are
+// replaced. If there are no constants to replace the CallExpr is returned
+// unchanged, otherwise a copy is returned. An error is returned if the
+// negation of a constant cannot be represented.
+func (p Parser[T]) substituteConstants(e *ast.CallExpr, args []string) (
+	*ast.CallExpr, error,
+) {
+	if e == nil || len(p.constants) == 0 {
+		return e, nil
+	}
+
+	var newCall *ast.CallExpr
+
+	for i, arg := range e.Args {
+		value, ok, err := p.constantArg(arg)
+		if !ok {
+			continue
+		}
+
+		kind, ok := argKindAt(args, i)
+		if !ok {
+			continue
+		}
+
+		if _, isChecker := parserRegister[kind]; isChecker {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if newCall == nil {
+			c := *e
+			c.Args = slices.Clone(e.Args)
+			newCall = &c
+		}
+
+		newCall.Args[i] = constantLit(value, arg.Pos())
+	}
+
+	if newCall == nil {
+		return e, nil
+	}
+
+	return newCall, nil
+}
+
+// addConstantsHint adds a list of the available constants to the error if
+// it reports an unknown constant
+func (p Parser[T]) addConstantsHint(err error) error {
+	var uce unknownConstError
+	if !errors.As(err, &uce) {
+		return err
+	}
+
+	if len(p.constants) == 0 {
+		return fmt.Errorf("%w (%s has no constants)", err, p.checkerName)
+	}
+
+	return fmt.Errorf("%w (the %s constants are: %s)", err, p.checkerName,
+		strings.Join(slices.Sorted(maps.Keys(p.constants)), ", "))
+}
@@ -0,0 +1,237 @@
+package checksetter_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestAddConstant(t *testing.T) {
+	const checkerName = "TestAddConstant"
+
+	p, _ := mkNamedCheckTestParser(t, checkerName)
+
+	if err := p.AddNamedCheck("small", "LE(9)"); err != nil {
+		t.Fatal("couldn't add the named check: " + err.Error())
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name  string
+		value any
+	}{
+		{
+			ID:    testhelper.MkID("good: int"),
+			name:  "MaxWorkers",
+			value: 32,
+		},
+		{
+			ID:    testhelper.MkID("good: int64"),
+			name:  "MinWorkers",
+			value: int64(-2),
+		},
+		{
+			ID:    testhelper.MkID("good: float64"),
+			name:  "Ratio",
+			value: 1.5,
+		},
+		{
+			ID:    testhelper.MkID("good: string"),
+			name:  "Reason",
+			value: "a reserved value",
+		},
+		{
+			ID: testhelper.MkID("bad: duplicate"),
+			ExpErr: testhelper.MkExpErr(`bad name for a constant: "MaxWorkers"`,
+				"it is already a constant"),
+			name:  "MaxWorkers",
+			value: 1,
+		},
+		{
+			ID: testhelper.MkID("bad: maker name"),
+			ExpErr: testhelper.MkExpErr(`bad name for a constant: "EQ"`,
+				"it is already the name of a "+checkerName+" function"),
+			name:  "EQ",
+			value: 1,
+		},
+		{
+			ID: testhelper.MkID("bad: named check"),
+			ExpErr: testhelper.MkExpErr(`bad name for a constant: "small"`,
+				"it is already a named check"),
+			name:  "small",
+			value: 1,
+		},
+		{
+			ID: testhelper.MkID("bad: not an identifier"),
+			ExpErr: testhelper.MkExpErr(`bad name for a constant: "a-b"`,
+				"it must be a valid identifier"),
+			name:  "a-b",
+			value: 1,
+		},
+		{
+			ID: testhelper.MkID("bad: unsupported type"),
+			ExpErr: testhelper.MkExpErr(`bad value for the constant "B"`,
+				"unsupported type: bool"),
+			name:  "B",
+			value: true,
+		},
+		{
+			ID: testhelper.MkID("bad: quoted string"),
+			ExpErr: testhelper.MkExpErr(`bad value for the constant "Q"`,
+				`a string value cannot start or end with '"'`),
+			name:  "Q",
+			value: `"quoted"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := p.AddConstant(tc.name, tc.value)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+
+	testhelper.DiffInt(t, "constants", "count", len(p.Constants()), 4)
+
+	err := p.AddNamedCheck("MaxWorkers", "OK")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("named check with the name of a constant"),
+		ExpErr: testhelper.MkExpErr(`bad name for a named check: "MaxWorkers"`,
+			"it is already a constant"),
+	})
+
+	av := checksetter.AllowedValues(checkerName, p.MakerFuncs())
+	gfcAval.Check(t, "Allowed Values", "constants", []byte(av))
+}
+
+func TestParseConstants(t *testing.T) {
+	const checkerName = "TestParseConstants"
+
+	p, _ := mkNamedCheckTestParser(t, checkerName)
+
+	for name, val := range map[string]any{
+		"MaxWorkers": 32,
+		"Negative":   int64(-5),
+		"Ratio":      1.5,
+		"Reason":     "reserved",
+		"Smallest":   int64(math.MinInt64),
+	} {
+		if err := p.AddConstant(name, val); err != nil {
+			t.Fatal("couldn't add the constant: " + err.Error())
+		}
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr        string
+		ops         bool
+		passingVals []int
+		failingVals []int
+	}{
+		{
+			ID:          testhelper.MkID("int constant"),
+			expr:        "LE(MaxWorkers)",
+			passingVals: []int{0, 32},
+			failingVals: []int{33},
+		},
+		{
+			ID:          testhelper.MkID("negative constant"),
+			expr:        "GE(Negative)",
+			passingVals: []int{-5, 0},
+			failingVals: []int{-6},
+		},
+		{
+			ID:          testhelper.MkID("negated constant"),
+			expr:        "LE(-Negative)",
+			passingVals: []int{-5, 5},
+			failingVals: []int{6},
+		},
+		{
+			ID:          testhelper.MkID("negated constant, operators"),
+			expr:        ">= -MaxWorkers && <= -Negative",
+			ops:         true,
+			passingVals: []int{-32, 5},
+			failingVals: []int{-33, 6},
+		},
+		{
+			ID:          testhelper.MkID("nested constants"),
+			expr:        `And(GE(0), Not(EQ(MaxWorkers), Reason))`,
+			passingVals: []int{0, 31, 33},
+			failingVals: []int{-1, 32},
+		},
+		{
+			ID: testhelper.MkID("bad: wrong type"),
+			ExpErr: testhelper.MkExpErr(
+				`GE(int): "1.5" isn't an INT, it's a FLOAT`),
+			expr: "GE(Ratio)",
+		},
+		{
+			ID: testhelper.MkID("bad: unknown constant"),
+			ExpErr: testhelper.MkExpErr(`GE(int): unknown constant: "Nonesuch"`,
+				"(the "+checkerName+" constants are:"+
+					" MaxWorkers, Negative, Ratio, Reason, Smallest)"),
+			expr: "GE(Nonesuch)",
+		},
+		{
+			ID: testhelper.MkID("bad: negated constant overflows"),
+			ExpErr: testhelper.MkExpErr(
+				"GE(int): -Smallest is too big for an int64"),
+			expr: "GE(-Smallest)",
+		},
+		{
+			ID: testhelper.MkID("bad: negated string constant"),
+			ExpErr: testhelper.MkExpErr(
+				"Not("+checkerName+", string):",
+				"the expression isn't a BasicLit, it's a *ast.UnaryExpr"),
+			expr: "Not(OK, -Reason)",
+		},
+		{
+			ID: testhelper.MkID("bad: constant used as a check"),
+			ExpErr: testhelper.MkExpErr(
+				"MaxWorkers is an unknown function"),
+			expr: "And(MaxWorkers)",
+		},
+	}
+
+	for _, tc := range testCases {
+		tp := &p
+		if tc.ops {
+			tp = p.WithOperators()
+		}
+
+		vcs, err := tp.Parse(tc.expr)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			for _, v := range tc.passingVals {
+				if err := vcs[0](v); err != nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: unexpected error checking %d: %s", v, err)
+					t.Error("\t: Bad check")
+				}
+			}
+
+			for _, v := range tc.failingVals {
+				if err := vcs[0](v); err == nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: missing error checking %d", v)
+					t.Error("\t: Bad check")
+				}
+			}
+		}
+	}
+
+	_, err := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName).
+		Parse("LE(MaxWorkers)")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("no constants"),
+		ExpErr: testhelper.MkExpErr(`unknown constant: "MaxWorkers"`,
+			"(int-checker has no constants)"),
+	})
+}
@@ -13,5 +13,96 @@ If you choose to write your own Parser you should do it by calling the
 MakeParser func which will register the Parser so that it can be retrieved
 with the FindParser func. This will then also allow the Setter to provide
 correct AllowedValues.
+
+A check can be given a name, either by the program calling the
+AddNamedCheck method on the Parser or by the user writing 'name: check' in
+the list of checks. The name can then be used wherever a check of that
+family is expected. Names given by the user are only visible within the
+list in which they are defined.
+
+The program can also add named constants to a Parser with the AddConstant
+method. The user can then give the name of the constant in place of a
+literal value in the arguments to that Parser's functions.
+
+Numeric arguments can also be given with a unit, written as a string such
+as "512MiB", "1.5k" or "10%". Counts, such as the number of checks given
+to AtLeast, cannot be given with a unit. Every Parser starts with the SI
+and IEC multipliers and '%'; use the AddUnit and RemoveUnit methods to
+change the units that a Parser recognises.
+
+A Parser returned by the WithOperators method will also accept checks
+written with Go-like operators, such as '>= 0 && < 100 || == -1'. The
+operators are translated into calls of the corresponding functions (GE, LT,
+EQ, And, Or, Not and so on) and can be combined with checks written as
+functions, though not used within their arguments: 'And(> 1, < 5)' must be
+written as 'And(GT(1), LT(5))'. Use this Parser in a Setter to let the user
+write checks this way.
+
+A Parser returned by the WithOptimiser method will simplify the checks
+before making them: nested And and Or checks are flattened, OK checks are
+removed, bounds are merged and an Or of EQ checks becomes a single set
+lookup. This makes checking values faster but the error messages may
+differ from those of the checks as written.
+
+Long lists of checks can be kept in a file and read with the ParseFile
+method; the Setter will do this if the parameter value starts with '@'. In
+the file, comments are ignored, the checks can be given one per line and a
+check can be split over several lines. Any error is reported with the file
+name, line and column of the problem.
+
+The Analyse and AnalyseFile methods parse the checks and then look for
+lists of checks that no value could pass, such as 'GT(10), LT(5)', and for
+checks which are redundant, such as the first check in 'GT(1), GT(5)'. Set
+the Analyse field of a Setter to have the parameter value rejected if the
+checks can never pass.
+
+The DeriveBounds func uses the same analysis to describe the range of
+values which may pass a list of checks of numbers, for instance "[1,
+65535] excluding {8080}". The lowest and highest values can be used in
+help text or to set limits in a user interface.
+
+The Examples method generates values which pass a list of checks and values
+which just fail them, such as those either side of a bound. These can be
+used in tests of code which is to be given values checked by
+user-supplied checks.
+
+The Compare method reports whether a new list of checks is equivalent to,
+stricter than or looser than an old one, with counter-examples where they
+differ. This is decided exactly for comparison checks and by trying
+sample values otherwise.
+
+The Explain method applies a list of checks to a value and returns a
+Trace for each check, mirroring the source, showing which parts passed
+and which failed together with their position in the source. This can
+be used to show a user exactly which part of their check rejected a value.
+
+The ParseList method returns a CheckList which holds the checks together
+with their source text. Its Check method returns the first failure and
+its CheckAll method returns every failure, each annotated with the index
+and source of the check. A Setter will populate a CheckList if one is
+given.
+
+The SingleSetter can be used in place of the Setter where a single check
+func is wanted. It combines the checks into one which passes only if they
+all pass and reports the combined check as its current value.
+
+The Complete method supports interactive completion of a partially typed
+list of checks. It reports the kind of value expected at the cursor and
+the names which can be given there, following the nesting of the checks
+so that, for instance, the int-checker functions are offered inside the
+Length check of the string-checker.
+
+The MakerInfo values for your Parser can be built with the MakerNoArgs,
+Maker1, Maker2, Maker3, MakerVariadic, Maker1Variadic and MakerChecker
+funcs. These take a map of ordinary check-func makers and the ArgDecoders
+needed to convert the arguments (IntArg, StringArg, CheckerArg and so
+on). They take care of finding the function by name, checking the number
+of arguments, reporting errors and setting the Args used to describe the
+function.
+
+The standard checker families are generated from the spec files in the
+specs directory by the mkchecker command (see the go:generate directive in
+generate.go). To add a function to one of these families, or to add a new
+family, change or add a spec and run go generate.
 */
 package checksetter
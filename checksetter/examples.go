@@ -0,0 +1,486 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp/syntax"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// maxExampleLits is the maximum number of literal values taken from the
+// checks when generating example values
+const maxExampleLits = 20
+
+// defaultExampleInts are tried as example values of every numeric type
+var defaultExampleInts = []int64{0, 1, -1, 2, 10, 100, 1000}
+
+// defaultExampleStrings are tried as example string values. They are
+// chosen to pass and fail the checks provided by the string-checker.
+var defaultExampleStrings = []string{
+	"", "a", "abc", "ABC", " abc ", "a b", "123", "1.5", "true", "1h",
+	"1.2.3", "127.0.0.1", "::1", "example.com", "example.com:80",
+	"user@example.com", "http://example.com/", "\x00",
+}
+
+// exampleLits holds the literal values found in a list of checks
+type exampleLits struct {
+	nums    []*big.Rat
+	strs    []string
+	lengths []int
+}
+
+// addNum adds the number to the literal values
+func (el *exampleLits) addNum(r *big.Rat) {
+	if len(el.nums) < maxExampleLits {
+		el.nums = append(el.nums, r)
+	}
+
+	if r.IsInt() && r.Num().IsInt64() && r.Num().Int64() >= 0 &&
+		r.Num().Int64() <= math.MaxInt16 && len(el.lengths) < maxExampleLits {
+		el.lengths = append(el.lengths, int(r.Num().Int64()))
+	}
+}
+
+// addString adds the string to the literal values. If the string is a
+// regular expression then a string it matches is also added.
+func (el *exampleLits) addString(s string) {
+	if len(el.strs) >= maxExampleLits {
+		return
+	}
+
+	el.strs = append(el.strs, s)
+
+	if m, ok := regexpExample(s); ok && m != s {
+		el.strs = append(el.strs, m)
+	}
+}
+
+// collectExampleLits adds the literal values from the expression to the
+// exampleLits. Named checks and constants are followed and numbers with
+// units are converted.
+func (p Parser[T]) collectExampleLits(el *exampleLits, expr ast.Expr) {
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch e := n.(type) {
+		case *ast.Ident:
+			if nc, ok := p.namedChecks[e.Name]; ok {
+				p.collectExampleLits(el, nc.expr)
+			}
+
+			if c, ok := p.constants[e.Name]; ok {
+				lit := constantLit(c, e.Pos())
+				p.collectExampleLits(el, lit)
+			}
+		case *ast.UnaryExpr:
+			if f, err := getFloat64(e); err == nil && !math.IsInf(f, 0) {
+				el.addNum(new(big.Rat).SetFloat64(f))
+				return false
+			}
+		case *ast.BasicLit:
+			p.addBasicLit(el, e)
+		}
+
+		return true
+	})
+}
+
+// addBasicLit adds the value of the literal to the exampleLits
+func (p Parser[T]) addBasicLit(el *exampleLits, lit *ast.BasicLit) {
+	switch lit.Kind {
+	case token.INT, token.FLOAT:
+		if r, ok := new(big.Rat).SetString(lit.Value); ok {
+			el.addNum(r)
+		}
+	case token.STRING:
+		s, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return
+		}
+
+		el.addString(s)
+
+		if r, ok := p.unitValue(s); ok {
+			el.addNum(r)
+		}
+
+		if d, err := time.ParseDuration(s); err == nil {
+			el.addNum(big.NewRat(int64(d), 1))
+		}
+	}
+}
+
+// regexpExample returns a string matched by the regular expression. It
+// returns false if the string is not a valid regular expression or no
+// example can be found.
+func regexpExample(s string) (string, bool) {
+	re, err := syntax.Parse(s, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	var sb strings.Builder
+
+	ok := writeRegexpExample(&sb, re.Simplify())
+
+	return sb.String(), ok
+}
+
+// writeRegexpExample writes a string matched by the regular expression to
+// the builder. It returns false if no example can be found.
+func writeRegexpExample(sb *strings.Builder, re *syntax.Regexp) bool {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return false
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		if len(re.Rune) == 0 {
+			return false
+		}
+
+		sb.WriteRune(re.Rune[0])
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteRune('a')
+	case syntax.OpCapture, syntax.OpPlus:
+		return writeRegexpExample(sb, re.Sub[0])
+	case syntax.OpRepeat:
+		for range re.Min {
+			if !writeRegexpExample(sb, re.Sub[0]) {
+				return false
+			}
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if !writeRegexpExample(sb, sub) {
+				return false
+			}
+		}
+	case syntax.OpAlternate:
+		return writeRegexpExample(sb, re.Sub[0])
+	}
+
+	return true
+}
+
+// numCandidates returns the candidate example numbers: the literal values
+// and the values at and either side of the bounds of the analysed ranges
+func numCandidates(el exampleLits, ranges []valRange, integral bool,
+) []*big.Rat {
+	var (
+		cands []*big.Rat
+		step  = big.NewRat(1, 1)
+	)
+
+	if !integral {
+		step = big.NewRat(1, 1000) //nolint:mnd
+	}
+
+	around := func(r *big.Rat) {
+		cands = append(cands,
+			new(big.Rat).Sub(r, step), r, new(big.Rat).Add(r, step))
+	}
+
+	for _, vr := range ranges {
+		for _, iv := range vr.ivs {
+			if !iv.lo.unbounded {
+				around(iv.lo.val.num)
+			}
+
+			if !iv.hi.unbounded {
+				around(iv.hi.val.num)
+			}
+
+			if !iv.lo.unbounded && !iv.hi.unbounded {
+				mid := new(big.Rat).Add(iv.lo.val.num, iv.hi.val.num)
+				mid.Mul(mid, big.NewRat(1, 2)) //nolint:mnd
+
+				if integral {
+					mid.SetInt(new(big.Int).Quo(mid.Num(), mid.Denom()))
+				}
+
+				cands = append(cands, mid)
+			}
+		}
+	}
+
+	for _, r := range el.nums {
+		around(r)
+		cands = append(cands,
+			new(big.Rat).Neg(r),
+			new(big.Rat).Mul(r, big.NewRat(2, 1)), //nolint:mnd
+			new(big.Rat).Mul(r, big.NewRat(3, 1))) //nolint:mnd
+	}
+
+	for _, i := range defaultExampleInts {
+		cands = append(cands, big.NewRat(i, 1))
+	}
+
+	return cands
+}
+
+// strCandidates returns the candidate example strings: the literal values,
+// combinations of them and strings of the lengths given in the checks
+func strCandidates(el exampleLits) []string {
+	cands := slices.Clone(el.strs)
+
+	for _, s := range el.strs {
+		cands = append(cands, s+"x", "x"+s, strings.ToUpper(s))
+
+		for _, s2 := range el.strs {
+			if s2 != s {
+				cands = append(cands, s+s2, s+"x"+s2)
+			}
+		}
+	}
+
+	for _, l := range el.lengths {
+		for _, n := range []int{l - 1, l, l + 1} {
+			if n >= 0 {
+				cands = append(cands, strings.Repeat("a", n))
+			}
+		}
+
+		for _, s := range el.strs {
+			if pad := l - len(s); pad > 0 {
+				cands = append(cands, s+strings.Repeat("a", pad))
+			}
+		}
+	}
+
+	return append(cands, defaultExampleStrings...)
+}
+
+// sliceCandidates returns the candidate example string slices made from
+// the candidate strings and the lengths given in the checks
+func sliceCandidates(el exampleLits) [][]string {
+	strs := strCandidates(el)
+	cands := [][]string{{}}
+
+	for _, s := range strs {
+		cands = append(cands, []string{s}, []string{s, s})
+	}
+
+	for i := 1; i < len(strs); i++ {
+		cands = append(cands, []string{strs[i-1], strs[i]})
+	}
+
+	for _, l := range el.lengths {
+		for _, n := range []int{l - 1, l, l + 1} {
+			if n <= 0 {
+				continue
+			}
+
+			distinct := make([]string, 0, n)
+			same := make([]string, 0, n)
+
+			for i := range n {
+				distinct = append(distinct, strs[i%len(strs)])
+				same = append(same, strs[0])
+			}
+
+			cands = append(cands, distinct, same)
+		}
+	}
+
+	return cands
+}
+
+// ratCandidates converts the candidate numbers to the type T using the
+// conversion func, dropping any which cannot be represented
+func ratCandidates[T any](rats []*big.Rat, integral bool,
+	conv func(*big.Rat) T,
+) []T {
+	cands := make([]T, 0, len(rats))
+
+	for _, r := range rats {
+		if integral && (!r.IsInt() || !r.Num().IsInt64()) {
+			continue
+		}
+
+		cands = append(cands, conv(r))
+	}
+
+	return cands
+}
+
+// exampleCandidates returns the candidate examples for the type T. The
+// candidates depend on the kind of T so that they can be generated for
+// named types, such as time.Duration, as well as the basic types. It
+// returns an error if examples cannot be generated for the type.
+func exampleCandidates[T any](el exampleLits, ranges []valRange) ([]T, error) {
+	var (
+		rt   = reflect.TypeFor[T]()
+		vals []T
+	)
+
+	add := func(set func(v reflect.Value) bool) {
+		v := reflect.New(rt).Elem()
+		if set(v) {
+			vals = append(vals, v.Interface().(T)) //nolint:forcetypeassert
+		}
+	}
+
+	toInt64 := func(r *big.Rat) int64 { return r.Num().Int64() }
+	toFloat := func(r *big.Rat) float64 { f, _ := r.Float64(); return f }
+
+	switch rt.Kind() {
+	case reflect.Int, reflect.Int64:
+		for _, i := range ratCandidates(numCandidates(el, ranges, true), true,
+			toInt64) {
+			add(func(v reflect.Value) bool {
+				if v.OverflowInt(i) {
+					return false
+				}
+
+				v.SetInt(i)
+
+				return true
+			})
+		}
+	case reflect.Float64:
+		fs := ratCandidates(numCandidates(el, ranges, false), false, toFloat)
+		for _, f := range append(fs, math.Inf(1), math.Inf(-1), math.NaN()) {
+			add(func(v reflect.Value) bool { v.SetFloat(f); return true })
+		}
+	case reflect.String:
+		for _, s := range strCandidates(el) {
+			add(func(v reflect.Value) bool { v.SetString(s); return true })
+		}
+	case reflect.Slice:
+		if rt.Elem().Kind() != reflect.String {
+			return nil, fmt.Errorf("can't generate examples of type %s", rt)
+		}
+
+		for _, ss := range sliceCandidates(el) {
+			add(func(v reflect.Value) bool {
+				v.Set(reflect.MakeSlice(rt, len(ss), len(ss)))
+
+				for i, s := range ss {
+					v.Index(i).SetString(s)
+				}
+
+				return true
+			})
+		}
+	case reflect.Bool:
+		for _, b := range []bool{true, false} {
+			add(func(v reflect.Value) bool { v.SetBool(b); return true })
+		}
+	default:
+		return nil, fmt.Errorf("can't generate examples of type %s", rt)
+	}
+
+	return vals, nil
+}
+
+// exampleCands returns the candidate examples for the list of checks,
+// which must already have been successfully parsed
+func (p Parser[T]) exampleCands(elts []ast.Expr) ([]T, error) {
+	var (
+		el     exampleLits
+		ranges []valRange
+	)
+
+	a, list, ri, err := p.analyseList(elts, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if a != nil {
+		ranges = append(ranges, ri.may)
+		for _, e := range list.Elts {
+			ranges = append(ranges, a.info(e).may)
+		}
+	}
+
+	for _, e := range elts {
+		p.collectExampleLits(&el, e)
+	}
+
+	return exampleCandidates[T](el, ranges)
+}
+
+// tryCheck applies the check to the value and returns true if it passes.
+// Some checks panic when given certain values, for instance Divides(60)
+// when given 0, so any panic is recovered and false is returned as the
+// second value.
+func tryCheck[T any](cf check.ValCk[T], v T) (passed, ok bool) {
+	defer func() {
+		if recover() != nil {
+			passed, ok = false, false
+		}
+	}()
+
+	return cf(v) == nil, true
+}
+
+// Examples parses the list of checks, as for the Parser's Parse method, and
+// returns up to n example values which pass all the checks and up to n
+// which fail them. The failing values are chosen to be close to the values
+// which pass so they are useful in testing; for instance, for the
+// int-checker, 'Between(1, 10)' gives failing values of 0 and 11.
+//
+// The examples are found by trying candidate values made from the
+// literals in the checks and the bounds found by analysing them (see the
+// Parser's Analyse method) and so every example is known to pass or fail.
+// Fewer than n examples are returned if not enough can be found. Examples
+// can be generated for values whose type has an underlying type of int,
+// int64, float64, string, []string or bool, such as time.Duration; any
+// other type gives an error.
+func (p Parser[T]) Examples(s string, n int) (
+	valid, invalid []T, err error,
+) {
+	elts, err := p.listElts(s)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vcs, err := p.makeCheckFuncs(elts, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cands, err := p.exampleCands(elts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cf := check.And(vcs...)
+	seen := map[string]bool{}
+
+	for _, c := range cands {
+		key := fmt.Sprintf("%#v", c)
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		passed, ok := tryCheck(cf, c)
+		if !ok {
+			continue
+		}
+
+		if passed {
+			if len(valid) < n {
+				valid = append(valid, c)
+			}
+		} else if len(invalid) < n {
+			invalid = append(invalid, c)
+		}
+
+		if len(valid) == n && len(invalid) == n {
+			break
+		}
+	}
+
+	return valid, invalid, nil
+}
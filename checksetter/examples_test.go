@@ -0,0 +1,291 @@
+package checksetter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// testExamples checks that the Examples are as expected: that the valid
+// values pass the checks, the invalid values fail them, the expected
+// values are present and there are no more than n of each
+func testExamples[T any](t *testing.T, id testhelper.ID, ee testhelper.ExpErr,
+	checkerName, expr string, n int, expValid, expInvalid []T,
+) {
+	t.Helper()
+
+	p := checksetter.FindParserOrPanic[T](checkerName)
+
+	valid, invalid, err := p.Examples(expr, n)
+	if !testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{ID: id, ExpErr: ee}) || err != nil {
+		return
+	}
+
+	vcs, err := p.Parse(expr)
+	if err != nil {
+		t.Fatal(id.IDStr(), ": unexpected parse error: ", err)
+	}
+
+	passes := func(v T) bool {
+		for _, vc := range vcs {
+			if vc(v) != nil {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if len(valid) > n || len(invalid) > n {
+		t.Log(id.IDStr())
+		t.Errorf("\t: too many examples: %d valid, %d invalid (max: %d)",
+			len(valid), len(invalid), n)
+	}
+
+	for _, v := range valid {
+		if !passes(v) {
+			t.Log(id.IDStr())
+			t.Errorf("\t: the valid example %#v fails the checks", v)
+		}
+	}
+
+	for _, v := range invalid {
+		if passes(v) {
+			t.Log(id.IDStr())
+			t.Errorf("\t: the invalid example %#v passes the checks", v)
+		}
+	}
+
+	has := func(vals []T, v T) bool {
+		return slices.ContainsFunc(vals, func(x T) bool {
+			return fmt.Sprintf("%#v", x) == fmt.Sprintf("%#v", v)
+		})
+	}
+
+	for _, v := range expValid {
+		if !has(valid, v) {
+			t.Log(id.IDStr())
+			t.Errorf("\t: %#v is not in the valid examples: %#v", v, valid)
+		}
+	}
+
+	for _, v := range expInvalid {
+		if !has(invalid, v) {
+			t.Log(id.IDStr())
+			t.Errorf("\t: %#v is not in the invalid examples: %#v",
+				v, invalid)
+		}
+	}
+}
+
+func TestExamplesInt(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr       string
+		n          int
+		expValid   []int
+		expInvalid []int
+	}{
+		{
+			ID:         testhelper.MkID("Between"),
+			expr:       "Between(1, 10)",
+			n:          5,
+			expValid:   []int{1, 10},
+			expInvalid: []int{0, 11},
+		},
+		{
+			ID:         testhelper.MkID("exclusion"),
+			expr:       "GE(1), LE(65535), NoneOf(8080)",
+			n:          20,
+			expValid:   []int{1, 8079, 8081, 65535},
+			expInvalid: []int{0, 8080, 65536},
+		},
+		{
+			ID:         testhelper.MkID("non-comparison checks"),
+			expr:       "GT(0), Divides(60)",
+			n:          5,
+			expValid:   []int{1, 60},
+			expInvalid: []int{0},
+		},
+		{
+			ID:         testhelper.MkID("units"),
+			expr:       `LT("1Ki")`,
+			n:          5,
+			expValid:   []int{1023},
+			expInvalid: []int{1024},
+		},
+		{
+			ID:       testhelper.MkID("no failing values"),
+			expr:     "OK",
+			n:        3,
+			expValid: []int{0},
+		},
+		{
+			ID:         testhelper.MkID("no passing values"),
+			expr:       "GT(10), LT(5)",
+			n:          3,
+			expInvalid: []int{10},
+		},
+		{
+			ID: testhelper.MkID("bad checks"),
+			ExpErr: testhelper.MkExpErr(
+				"can't make int-checker function"),
+			expr: "Nonesuch",
+			n:    3,
+		},
+	}
+
+	for _, tc := range testCases {
+		testExamples(t, tc.ID, tc.ExpErr, checksetter.IntCheckerName,
+			tc.expr, tc.n, tc.expValid, tc.expInvalid)
+	}
+}
+
+func TestExamplesFloat64(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		expr       string
+		expValid   []float64
+		expInvalid []float64
+	}{
+		{
+			ID:         testhelper.MkID("open bounds"),
+			expr:       "GT(0.5), LT(1.5)",
+			expValid:   []float64{1},
+			expInvalid: []float64{0.5, 1.5},
+		},
+		{
+			ID:         testhelper.MkID("closed bounds"),
+			expr:       "Between(-2.5, 2.5)",
+			expValid:   []float64{-2.5, 2.5},
+			expInvalid: []float64{-2.501, 2.501},
+		},
+	}
+
+	for _, tc := range testCases {
+		testExamples(t, tc.ID, testhelper.ExpErr{},
+			checksetter.Float64CheckerName,
+			tc.expr, 10, tc.expValid, tc.expInvalid)
+	}
+}
+
+func TestExamplesDuration(t *testing.T) {
+	testExamples(t, testhelper.MkID("duration bounds"), testhelper.ExpErr{},
+		checksetter.DurationCheckerName,
+		`GE("1s"), LT("1m")`, 10,
+		[]time.Duration{time.Second, time.Minute - 1},
+		[]time.Duration{time.Second - 1, time.Minute})
+}
+
+func TestExamplesString(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		expr       string
+		expValid   []string
+		expInvalid []string
+	}{
+		{
+			ID:         testhelper.MkID("prefix and suffix"),
+			expr:       `HasPrefix("ab"), HasSuffix("yz")`,
+			expValid:   []string{"abyz", "abxyz"},
+			expInvalid: []string{"ab", "yz"},
+		},
+		{
+			ID:         testhelper.MkID("length"),
+			expr:       `Length(EQ(3))`,
+			expValid:   []string{"aaa"},
+			expInvalid: []string{"aa", "aaaa"},
+		},
+		{
+			ID:         testhelper.MkID("pattern"),
+			expr:       `MatchesPattern("^[a-z]+-[0-9]{2}$", "a name-NN")`,
+			expValid:   []string{"a-00"},
+			expInvalid: []string{"A-00"},
+		},
+	}
+
+	for _, tc := range testCases {
+		testExamples(t, tc.ID, testhelper.ExpErr{},
+			checksetter.StringCheckerName,
+			tc.expr, 20, tc.expValid, tc.expInvalid)
+	}
+}
+
+func TestExamplesStringSlice(t *testing.T) {
+	testCases := []struct {
+		testhelper.ID
+		expr       string
+		expValid   [][]string
+		expInvalid [][]string
+	}{
+		{
+			ID:         testhelper.MkID("no duplicates"),
+			expr:       `NoDups, Length(GT(1))`,
+			expValid:   [][]string{{"", "a"}},
+			expInvalid: [][]string{{}, {""}, {"", ""}},
+		},
+		{
+			ID:         testhelper.MkID("element checks"),
+			expr:       `SliceAny(HasPrefix("x"), "an x value")`,
+			expValid:   [][]string{{"x"}},
+			expInvalid: [][]string{{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		testExamples(t, tc.ID, testhelper.ExpErr{},
+			checksetter.StringSliceCheckerName,
+			tc.expr, 20, tc.expValid, tc.expInvalid)
+	}
+}
+
+// testPort is a named type used to check that examples can be generated
+// for types other than the basic types
+type testPort int
+
+func TestExamplesNamedType(t *testing.T) {
+	const checkerName = "TestExamplesNamedType"
+
+	_, err := checksetter.MakeParser(checkerName,
+		map[string]checksetter.MakerInfo[testPort]{
+			"Between": checksetter.Maker2(
+				map[string]func(int, int) check.ValCk[testPort]{
+					"Between": func(lo, hi int) check.ValCk[testPort] {
+						return check.ValBetween[testPort](
+							testPort(lo), testPort(hi))
+					},
+				},
+				checksetter.IntArg, checksetter.IntArg),
+		})
+	if err != nil {
+		t.Fatal("couldn't make the parser: " + err.Error())
+	}
+
+	testExamples(t, testhelper.MkID("named int"), testhelper.ExpErr{},
+		checkerName, "Between(1, 1024)", 10,
+		[]testPort{1, 1024}, []testPort{0, 1025})
+
+	const badCheckerName = "TestExamplesNamedTypeBad"
+
+	_, err = checksetter.MakeParser(badCheckerName,
+		map[string]checksetter.MakerInfo[struct{}]{
+			"OK": checksetter.MakerNoArgs(
+				map[string]check.ValCk[struct{}]{"OK": check.ValOK[struct{}]}),
+		})
+	if err != nil {
+		t.Fatal("couldn't make the parser: " + err.Error())
+	}
+
+	testExamples(t, testhelper.MkID("unsupported type"),
+		testhelper.MkExpErr("can't generate examples of type struct {}"),
+		badCheckerName, "OK", 10, []struct{}{}, []struct{}{})
+}
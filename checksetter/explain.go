@@ -0,0 +1,296 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// Trace records the result of applying a check to a value. The Children
+// are the Traces of any checks of the same family given as arguments to
+// the check, such as the parts of an And or an Or, in the order they
+// appear in the source. Checks of other families, such as the int-checker
+// given to the Length check of a string-checker, are not traced separately
+// as they are applied to values derived from the checked value.
+type Trace struct {
+	// Check is the source text of the check
+	Check string
+	// Start and End give the span of the check in the source, End being
+	// the position just after the check. The Offset is the byte offset
+	// into the string of checks; the Line and Column are counted from 1.
+	Start, End token.Position
+
+	Passed bool
+	// Err is the error reported by the check if it did not pass
+	Err error
+
+	Children []*Trace
+}
+
+// String returns a description of the Trace and its Children, one check
+// per line with the Children indented below the check that contains them.
+func (t *Trace) String() string {
+	var sb strings.Builder
+
+	t.write(&sb, "")
+
+	return sb.String()
+}
+
+// write writes the description of the Trace to the builder with each line
+// prefixed by the indent
+func (t *Trace) write(sb *strings.Builder, indent string) {
+	result := "pass"
+	if !t.Passed {
+		result = "FAIL"
+	}
+
+	fmt.Fprintf(sb, "%s%s %d:%d: %s", indent, result,
+		t.Start.Line, t.Start.Column, t.Check)
+
+	if t.Err != nil {
+		fmt.Fprintf(sb, ": %s", t.Err)
+	}
+
+	sb.WriteString("\n")
+
+	for _, c := range t.Children {
+		c.write(sb, indent+"    ")
+	}
+}
+
+// srcSpans finds the spans of the checks in the source they were parsed
+// from
+type srcSpans struct {
+	src       string
+	fset      *token.FileSet
+	lineStart []int
+}
+
+// mkSrcSpans returns a srcSpans for the source. The FileSet must give the
+// positions of the checks relative to the start of the source.
+func mkSrcSpans(src string, fset *token.FileSet) srcSpans {
+	ss := srcSpans{src: src, fset: fset}
+
+	offset := 0
+	for _, line := range strings.SplitAfter(src, "\n") {
+		ss.lineStart = append(ss.lineStart, offset)
+		offset += len(line)
+	}
+
+	return ss
+}
+
+// position returns the position in the source of the Pos, setting the
+// Offset to be relative to the start of the source
+func (ss srcSpans) position(pos token.Pos) token.Position {
+	ps := ss.fset.Position(pos)
+	if ps.Line > 0 && ps.Line <= len(ss.lineStart) {
+		ps.Offset = ss.lineStart[ps.Line-1] + ps.Column - 1
+	}
+
+	return ps
+}
+
+// span returns the source text of the check and the positions of its start
+// and of the character after its end
+func (ss srcSpans) span(expr ast.Expr) (string, token.Position, token.Position) {
+	startPos, endPos := exprSpan(expr)
+	start, end := ss.position(startPos), ss.position(endPos)
+
+	if start.Offset < 0 || end.Offset > len(ss.src) ||
+		start.Offset > end.Offset {
+		return "", start, end
+	}
+
+	end = ss.closeParens(start, end)
+
+	return ss.src[start.Offset:end.Offset], start, end
+}
+
+// explainer holds the state needed to build the Traces
+type explainer[T any] struct {
+	srcSpans
+
+	p    Parser[T]
+	defs localDefs
+}
+
+// exprSpan returns the first and last positions of the expression. Checks
+// written with operators are translated into calls whose opening
+// parenthesis is at the position of the operator; the span of these is
+// found from the parts of the expression, ignoring any argument added in
+// the translation.
+func exprSpan(expr ast.Expr) (start, end token.Pos) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || call.Lparen != call.Fun.Pos() {
+		return expr.Pos(), expr.End()
+	}
+
+	start, end = call.Lparen, call.Lparen+1
+
+	for _, arg := range call.Args {
+		if lit, ok := arg.(*ast.BasicLit); ok && lit.Pos() == call.Lparen {
+			continue
+		}
+
+		argStart, argEnd := exprSpan(arg)
+		start, end = min(start, argStart), max(end, argEnd)
+	}
+
+	return start, end
+}
+
+// closeParens returns the end of the check extended to include the
+// closing parentheses which match any unclosed parentheses in the check.
+// The parentheses used to group checks written with operators are not
+// recorded in the translated checks and so the span found for a check such
+// as '!(< 10)' would otherwise end before the final parenthesis.
+func (ss srcSpans) closeParens(start, end token.Position) token.Position {
+	var (
+		open    int
+		quote   byte
+		escaped bool
+	)
+
+	for i := start.Offset; i < end.Offset; i++ {
+		c := ss.src[i]
+
+		switch {
+		case escaped:
+			escaped = false
+		case quote != 0:
+			escaped = c == '\\' && quote == '"'
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '`':
+			quote = c
+		case c == '(':
+			open++
+		case c == ')':
+			open--
+		}
+	}
+
+	for i := end.Offset; open > 0 && i < len(ss.src); i++ {
+		switch ss.src[i] {
+		case ')':
+			open--
+
+			end.Column += i + 1 - end.Offset
+			end.Offset = i + 1
+		case ' ', '\t':
+		default:
+			return end
+		}
+	}
+
+	return end
+}
+
+// applyCheck applies the check to the value, converting any panic into an
+// error
+func applyCheck[T any](cf check.ValCk[T], v T) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("the check panicked: %v", r)
+		}
+	}()
+
+	return cf(v)
+}
+
+// trace returns the Trace of applying the check to the value
+func (ex explainer[T]) trace(expr ast.Expr, v T) (*Trace, error) {
+	t := &Trace{}
+	t.Check, t.Start, t.End = ex.span(expr)
+
+	cf, err := ex.p.ParseExpr(ex.p.expandLocalDefs(expr, ex.defs))
+	if err != nil {
+		return nil, err
+	}
+
+	t.Err = applyCheck(cf, v)
+	t.Passed = t.Err == nil
+
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return t, nil
+	}
+
+	name, _ := getFuncName(call)
+
+	mi, ok := ex.p.makers[name]
+	if !ok {
+		return t, nil
+	}
+
+	for i, arg := range call.Args {
+		if kind, ok := argKindAt(mi.Args, i); !ok || kind != ex.p.checkerName {
+			continue
+		}
+
+		child, err := ex.trace(arg, v)
+		if err != nil {
+			return nil, err
+		}
+
+		// A child with the same span as its parent was made in translating
+		// the parent from an operator, such as the EQ of the Not(EQ(5))
+		// that '!= 5' is translated into, and is not shown separately
+		if child.Start == t.Start && child.End == t.End {
+			t.Children = append(t.Children, child.Children...)
+			continue
+		}
+
+		t.Children = append(t.Children, child)
+	}
+
+	return t, nil
+}
+
+// Explain parses the list of checks, as for the Parse method, applies them
+// to the value and returns a Trace for each check in the list showing
+// whether it passed and, for checks combining other checks, which of those
+// passed. This can be used to show exactly which part of a check rejected
+// a value. Note that every part of a check is applied to the value even
+// where the result is already known, such as the second part of an Or
+// whose first part has passed, and that the checks are applied as written
+// even if the Parser has an optimiser.
+//
+// The named checks given in the list are not traced themselves but their
+// names can be used in the other checks.
+func (p Parser[T]) Explain(s string, v T) ([]*Trace, error) {
+	elts, fset, err := p.srcElts(s, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := p.makeCheckFuncs(elts, fset); err != nil {
+		return nil, err
+	}
+
+	exprs, defs, err := p.splitLocalDefs(elts)
+	if err != nil {
+		return nil, err
+	}
+
+	ex := explainer[T]{srcSpans: mkSrcSpans(s, fset), p: p, defs: defs}
+
+	traces := make([]*Trace, 0, len(exprs))
+
+	for _, e := range exprs {
+		t, err := ex.trace(e, v)
+		if err != nil {
+			return nil, err
+		}
+
+		traces = append(traces, t)
+	}
+
+	return traces, nil
+}
@@ -0,0 +1,118 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestExplain(t *testing.T) {
+	p := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		checks string
+		val    string
+		ops    bool
+		expStr string
+	}{
+		{
+			ID: testhelper.MkID("nested checks"),
+			checks: `And(Length(GT(3)),` +
+				` Or(HasPrefix("a"), HasSuffix("z")))`,
+			val: "abcd",
+			expStr: `pass 1:1: And(Length(GT(3)), Or(HasPrefix("a"), HasSuffix("z")))
+    pass 1:5: Length(GT(3))
+    pass 1:20: Or(HasPrefix("a"), HasSuffix("z"))
+        pass 1:23: HasPrefix("a")
+        FAIL 1:39: HasSuffix("z"): "abcd" should have "z" as a suffix
+`,
+		},
+		{
+			ID:     testhelper.MkID("several checks"),
+			checks: "Length(GT(3)),\n  HasPrefix(\"b\")",
+			val:    "abcd",
+			expStr: `pass 1:1: Length(GT(3))
+FAIL 2:3: HasPrefix("b"): "abcd" should have "b" as a prefix
+`,
+		},
+		{
+			ID:     testhelper.MkID("named check"),
+			checks: `b: HasPrefix("b"), Not(b, "starting with b")`,
+			val:    "bcd",
+			expStr: `FAIL 1:20: Not(b, "starting with b"):` +
+				` bcd should not be starting with b
+    pass 1:24: b
+`,
+		},
+		{
+			ID:     testhelper.MkID("operators"),
+			checks: `HasPrefix("a") || !(HasSuffix("d"))`,
+			val:    "bcd",
+			ops:    true,
+			expStr: `FAIL 1:1: HasPrefix("a") || !(HasSuffix("d")):` +
+				` either ["bcd" should have "a" as a prefix]` +
+				` or [bcd should not be accepted by: (HasSuffix("d"))]
+    FAIL 1:1: HasPrefix("a"): "bcd" should have "a" as a prefix
+    FAIL 1:19: !(HasSuffix("d")):` +
+				` bcd should not be accepted by: (HasSuffix("d"))
+        pass 1:21: HasSuffix("d")
+`,
+		},
+		{
+			ID:     testhelper.MkID("bad checks"),
+			ExpErr: testhelper.MkExpErr("1:1: can't make string-checker function"),
+			checks: "Nonesuch",
+		},
+	}
+
+	for _, tc := range testCases {
+		tp := p
+		if tc.ops {
+			tp = p.WithOperators()
+		}
+
+		traces, err := tp.Explain(tc.checks, tc.val)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			s := ""
+			for _, tr := range traces {
+				s += tr.String()
+			}
+
+			testhelper.DiffString(t, tc.IDStr(), "traces", s, tc.expStr)
+		}
+	}
+}
+
+func TestExplainSpan(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName).
+		WithOperators()
+
+	checks := "GT(0),\n>= 3 && (!= 5)"
+
+	traces, err := p.Explain(checks, 5)
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	testhelper.DiffInt(t, "explain", "number of traces", len(traces), 2)
+
+	tr := traces[1]
+	testhelper.DiffString(t, "explain", "check", tr.Check, ">= 3 && (!= 5)")
+	testhelper.DiffBool(t, "explain", "passed", tr.Passed, false)
+	testhelper.DiffInt(t, "explain", "start offset", tr.Start.Offset, 7)
+	testhelper.DiffInt(t, "explain", "end offset", tr.End.Offset, len(checks))
+	testhelper.DiffInt(t, "explain", "start line", tr.Start.Line, 2)
+	testhelper.DiffInt(t, "explain", "start column", tr.Start.Column, 1)
+
+	if len(tr.Children) == 2 {
+		testhelper.DiffString(t, "explain", "second child",
+			tr.Children[1].Check, "!= 5")
+		testhelper.DiffInt(t, "explain", "grandchildren",
+			len(tr.Children[1].Children), 0)
+	} else {
+		t.Errorf("expected 2 children, got %d", len(tr.Children))
+	}
+}
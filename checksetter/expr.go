@@ -5,6 +5,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"math"
 	"strconv"
 	"strings"
 
@@ -14,6 +15,10 @@ import (
 // getInt64 converts the expression which is expected to be a BasicLit into the
 // corresponding int64
 func getInt64(e ast.Expr) (int64, error) {
+	if id, ok := e.(*ast.Ident); ok {
+		return 0, unknownConstError{name: id.Name}
+	}
+
 	v, ok := e.(*ast.BasicLit)
 	if !ok {
 		return 0, fmt.Errorf(errFmtNotABasicLit, e)
@@ -38,9 +43,40 @@ func getInt(e ast.Expr) (int, error) {
 	return int(i), err
 }
 
+// infName is the identifier which can be given as a float64 argument to
+// represent infinity. It can be preceded by a sign.
+const infName = "Inf"
+
 // getFloat64 converts the expression which is expected to be a BasicLit into
-// the corresponding float64
+// the corresponding float64. The identifier Inf is also accepted and it, or
+// a BasicLit, may be preceded by a '+' or '-'.
 func getFloat64(e ast.Expr) (float64, error) {
+	if u, ok := e.(*ast.UnaryExpr); ok {
+		if u.Op != token.SUB && u.Op != token.ADD {
+			return 0, fmt.Errorf("bad unary operator (%s), only '+' or '-'"+
+				" are allowed", u.Op)
+		}
+
+		f, err := getFloat64(u.X)
+		if err != nil {
+			return 0, err
+		}
+
+		if u.Op == token.SUB {
+			return -f, nil
+		}
+
+		return f, nil
+	}
+
+	if id, ok := e.(*ast.Ident); ok {
+		if id.Name == infName {
+			return math.Inf(1), nil
+		}
+
+		return 0, unknownConstError{name: id.Name}
+	}
+
 	v, ok := e.(*ast.BasicLit)
 	if !ok {
 		return 0, fmt.Errorf(errFmtNotABasicLit, e)
@@ -61,6 +97,10 @@ func getFloat64(e ast.Expr) (float64, error) {
 // getString converts the expression which is expected to be a BasicLit into the
 // corresponding string
 func getString(e ast.Expr) (string, error) {
+	if id, ok := e.(*ast.Ident); ok {
+		return "", unknownConstError{name: id.Name}
+	}
+
 	v, ok := e.(*ast.BasicLit)
 	if !ok {
 		return "", fmt.Errorf(errFmtNotABasicLit, e)
@@ -103,11 +143,34 @@ func getElts(s, desc string) (e []ast.Expr, err error) {
 }
 
 // checkArgCount will return an error if the number of arguments in the
-// CallExpr is not equal to the given value, nil otherwise
+// CallExpr is not equal to the given value, nil otherwise. A nil CallExpr
+// (as passed for a function given by name alone) has no arguments.
 func checkArgCount(e *ast.CallExpr, n int) error {
-	if len(e.Args) != n {
+	argCount := 0
+	if e != nil {
+		argCount = len(e.Args)
+	}
+
+	if argCount != n {
 		return fmt.Errorf("the call has %d arguments, it should have %d",
-			len(e.Args), n)
+			argCount, n)
+	}
+
+	return nil
+}
+
+// checkMinArgCount will return an error if the number of arguments in the
+// CallExpr is less than the given value, nil otherwise. A nil CallExpr has
+// no arguments.
+func checkMinArgCount(e *ast.CallExpr, n int) error {
+	argCount := 0
+	if e != nil {
+		argCount = len(e.Args)
+	}
+
+	if argCount < n {
+		return fmt.Errorf("the call has %d arguments, it should have at least %d",
+			argCount, n)
 	}
 
 	return nil
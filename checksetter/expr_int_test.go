@@ -126,6 +126,11 @@ func TestGetInt(t *testing.T) {
 			ExpErr: testhelper.MkExpErr(
 				"the expression isn't a BasicLit, it's a *ast.CallExpr"),
 		},
+		{
+			ID:     testhelper.MkID("bad - an unknown identifier"),
+			param:  ast.NewIdent("X"),
+			ExpErr: testhelper.MkExpErr(`unknown constant: "X"`),
+		},
 		{
 			ID:    testhelper.MkID("bad - int too big"),
 			param: bigLitInt,
@@ -226,6 +231,11 @@ func TestGetString(t *testing.T) {
 			ExpErr: testhelper.MkExpErr(
 				"the expression isn't a BasicLit, it's a *ast.CallExpr"),
 		},
+		{
+			ID:     testhelper.MkID("bad - an unknown identifier"),
+			param:  ast.NewIdent("X"),
+			ExpErr: testhelper.MkExpErr(`unknown constant: "X"`),
+		},
 	}
 
 	for _, tc := range testCases {
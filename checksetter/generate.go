@@ -0,0 +1,6 @@
+package checksetter
+
+// The maker files for the standard checker families, together with their
+// tests, are generated from the spec files in the specs directory.
+
+//go:generate go run ../cmd/mkchecker -out-dir . -spec specs/makerBool.spec -spec specs/makerDuration.spec -spec specs/makerFloat64.spec -spec specs/makerInt.spec -spec specs/makerInt64.spec -spec specs/makerPath.spec -spec specs/makerString.spec -spec specs/makerStringSlice.spec
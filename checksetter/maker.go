@@ -0,0 +1,395 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"io/fs"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// variadicArgMarker is the entry in a MakerInfo's Args which shows that the
+// following argument may be repeated any number of times
+const variadicArgMarker = "..."
+
+// ArgDecoder describes how to convert an argument of a CallExpr into a value
+// of type A. The Name is used to construct the Args of a MakerInfo and
+// should describe the argument's type; for a nested checker it should be
+// the checker name so that the AllowedValues message can show the functions
+// of the nested family. The Decode func is given the whole CallExpr and the
+// index of the argument to be converted.
+type ArgDecoder[A any] struct {
+	Name   string
+	Decode func(e *ast.CallExpr, idx int) (A, error)
+}
+
+// IntArg decodes an int literal
+var IntArg = ArgDecoder[int]{
+	Name: "int",
+	Decode: func(e *ast.CallExpr, idx int) (int, error) {
+		return getInt(e.Args[idx])
+	},
+}
+
+// CountArg decodes an int literal giving a number of things, such as the
+// number of checks which must pass, rather than a value to be compared
+// with the value being checked. Unlike an IntArg it cannot be given with a
+// unit.
+var CountArg = ArgDecoder[int]{
+	Name: "count",
+	Decode: func(e *ast.CallExpr, idx int) (int, error) {
+		return getInt(e.Args[idx])
+	},
+}
+
+// Int64Arg decodes an int64 literal
+var Int64Arg = ArgDecoder[int64]{
+	Name: "int64",
+	Decode: func(e *ast.CallExpr, idx int) (int64, error) {
+		return getInt64(e.Args[idx])
+	},
+}
+
+// Float64Arg decodes a float64 literal; it will also accept an int literal,
+// the identifier Inf and either of these preceded by a sign, so "-Inf" and
+// "-1.5" are both allowed
+var Float64Arg = ArgDecoder[float64]{
+	Name: "float64",
+	Decode: func(e *ast.CallExpr, idx int) (float64, error) {
+		return getFloat64(e.Args[idx])
+	},
+}
+
+// StringArg decodes a string literal
+var StringArg = ArgDecoder[string]{
+	Name: "string",
+	Decode: func(e *ast.CallExpr, idx int) (string, error) {
+		return getString(e.Args[idx])
+	},
+}
+
+// DurationArg decodes a string literal holding a duration in the form
+// accepted by time.ParseDuration, for instance "1h30m"
+var DurationArg = ArgDecoder[time.Duration]{
+	Name: "duration",
+	Decode: func(e *ast.CallExpr, idx int) (time.Duration, error) {
+		durStr, err := getString(e.Args[idx])
+		if err != nil {
+			return 0, err
+		}
+
+		d, err := time.ParseDuration(durStr)
+		if err != nil {
+			return 0, fmt.Errorf("bad duration: %s", err)
+		}
+
+		return d, nil
+	},
+}
+
+// CIDRArg decodes a string literal holding an IP network in CIDR notation,
+// for instance "10.0.0.0/8"
+var CIDRArg = ArgDecoder[netip.Prefix]{
+	Name: "cidr",
+	Decode: func(e *ast.CallExpr, idx int) (netip.Prefix, error) {
+		cidrStr, err := getString(e.Args[idx])
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+
+		network, err := netip.ParsePrefix(cidrStr)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("bad CIDR: %s", err)
+		}
+
+		return network, nil
+	},
+}
+
+// SemverArg decodes a string literal holding a semantic version, for
+// instance "1.4.0"
+var SemverArg = ArgDecoder[string]{
+	Name: "semver",
+	Decode: func(e *ast.CallExpr, idx int) (string, error) {
+		version, err := getString(e.Args[idx])
+		if err != nil {
+			return "", err
+		}
+
+		if _, err := parseSemver(version); err != nil {
+			return "", fmt.Errorf("bad semantic version: %q: %s", version, err)
+		}
+
+		return version, nil
+	},
+}
+
+// PermArg decodes a string literal holding file permissions given as an
+// octal number, for instance "0644"
+var PermArg = ArgDecoder[fs.FileMode]{
+	Name: "perm",
+	Decode: func(e *ast.CallExpr, idx int) (fs.FileMode, error) {
+		permStr, err := getString(e.Args[idx])
+		if err != nil {
+			return 0, err
+		}
+
+		perm, err := strconv.ParseUint(permStr, 8, 32)
+		if err != nil || perm > uint64(fs.ModePerm) {
+			return 0, fmt.Errorf("bad permissions: %q should be"+
+				" an octal number no greater than %04o",
+				permStr, fs.ModePerm)
+		}
+
+		return fs.FileMode(perm), nil
+	},
+}
+
+// RegexpArg decodes a string literal and compiles it into a regular
+// expression
+var RegexpArg = ArgDecoder[*regexp.Regexp]{
+	Name: "regexp",
+	Decode: func(e *ast.CallExpr, idx int) (*regexp.Regexp, error) {
+		reStr, err := getString(e.Args[idx])
+		if err != nil {
+			return nil, err
+		}
+
+		re, err := regexp.Compile(reStr)
+		if err != nil {
+			return nil, fmt.Errorf("the regexp doesn't compile: %s", err)
+		}
+
+		return re, nil
+	},
+}
+
+// CheckerArg returns an ArgDecoder which will convert the argument into a
+// check func using the Parser registered for the given checker name.
+func CheckerArg[U any](checkerName string) ArgDecoder[check.ValCk[U]] {
+	return ArgDecoder[check.ValCk[U]]{
+		Name: checkerName,
+		Decode: func(e *ast.CallExpr, idx int) (check.ValCk[U], error) {
+			return getCheckFunc[U](e, idx, checkerName)
+		},
+	}
+}
+
+// mkMakerInfo returns a MakerInfo with the given Args whose MakerFunc will
+// look up the function by name, call mk to build the check func and wrap
+// any error (or panic) with a description of the function and its
+// arguments.
+func mkMakerInfo[T, F any](
+	funcs map[string]F,
+	args []string,
+	mk func(e *ast.CallExpr, f F) (check.ValCk[T], error),
+) MakerInfo[T] {
+	return MakerInfo[T]{
+		Args: args,
+
+		MF: func(e *ast.CallExpr, fName string) (
+			cf check.ValCk[T], err error,
+		) {
+			f, ok := funcs[fName]
+			if !ok {
+				return nil, fmt.Errorf(errFmtUnknownFunc, fName)
+			}
+
+			defer func() {
+				if err != nil {
+					err = fmt.Errorf("%s(%s): %w",
+						fName, strings.Join(args, ", "), err)
+				}
+			}()
+			defer func() {
+				if r := recover(); r != nil {
+					cf = nil
+					err = fmt.Errorf("%v", r)
+				}
+			}()
+
+			return mk(e, f)
+		},
+	}
+}
+
+// MakerNoArgs returns a MakerInfo for check funcs which take no
+// arguments. The function may be given either as a plain name or as a call
+// with no arguments.
+func MakerNoArgs[T any](funcs map[string]check.ValCk[T]) MakerInfo[T] {
+	return mkMakerInfo(funcs, []string{},
+		func(e *ast.CallExpr, f check.ValCk[T]) (check.ValCk[T], error) {
+			if err := checkArgCount(e, 0); err != nil {
+				return nil, err
+			}
+
+			return f, nil
+		})
+}
+
+// Maker1 returns a MakerInfo for functions taking a single argument which
+// will be converted by the ArgDecoder.
+func Maker1[T, A any](
+	funcs map[string]func(A) check.ValCk[T],
+	a ArgDecoder[A],
+) MakerInfo[T] {
+	return mkMakerInfo(funcs, []string{a.Name},
+		func(e *ast.CallExpr, f func(A) check.ValCk[T]) (
+			check.ValCk[T], error,
+		) {
+			if err := checkArgCount(e, 1); err != nil {
+				return nil, err
+			}
+
+			aVal, err := a.Decode(e, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			return f(aVal), nil
+		})
+}
+
+// Maker2 returns a MakerInfo for functions taking two arguments which will
+// be converted by the ArgDecoders.
+func Maker2[T, A, B any](
+	funcs map[string]func(A, B) check.ValCk[T],
+	a ArgDecoder[A],
+	b ArgDecoder[B],
+) MakerInfo[T] {
+	return mkMakerInfo(funcs, []string{a.Name, b.Name},
+		func(e *ast.CallExpr, f func(A, B) check.ValCk[T]) (
+			check.ValCk[T], error,
+		) {
+			if err := checkArgCount(e, 2); err != nil { //nolint:mnd
+				return nil, err
+			}
+
+			aVal, err := a.Decode(e, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			bVal, err := b.Decode(e, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			return f(aVal, bVal), nil
+		})
+}
+
+// Maker3 returns a MakerInfo for functions taking three arguments which
+// will be converted by the ArgDecoders.
+func Maker3[T, A, B, C any](
+	funcs map[string]func(A, B, C) check.ValCk[T],
+	a ArgDecoder[A],
+	b ArgDecoder[B],
+	c ArgDecoder[C],
+) MakerInfo[T] {
+	return mkMakerInfo(funcs, []string{a.Name, b.Name, c.Name},
+		func(e *ast.CallExpr, f func(A, B, C) check.ValCk[T]) (
+			check.ValCk[T], error,
+		) {
+			if err := checkArgCount(e, 3); err != nil { //nolint:mnd
+				return nil, err
+			}
+
+			aVal, err := a.Decode(e, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			bVal, err := b.Decode(e, 1)
+			if err != nil {
+				return nil, err
+			}
+
+			cVal, err := c.Decode(e, 2) //nolint:mnd
+			if err != nil {
+				return nil, err
+			}
+
+			return f(aVal, bVal, cVal), nil
+		})
+}
+
+// MakerVariadic returns a MakerInfo for functions taking any number of
+// arguments, all of which will be converted by the ArgDecoder.
+func MakerVariadic[T, A any](
+	funcs map[string]func(...A) check.ValCk[T],
+	a ArgDecoder[A],
+) MakerInfo[T] {
+	return mkMakerInfo(funcs, []string{variadicArgMarker, a.Name},
+		func(e *ast.CallExpr, f func(...A) check.ValCk[T]) (
+			check.ValCk[T], error,
+		) {
+			aVals := []A{}
+
+			if e != nil {
+				aVals = make([]A, 0, len(e.Args))
+
+				for i := range e.Args {
+					aVal, err := a.Decode(e, i)
+					if err != nil {
+						return nil, err
+					}
+
+					aVals = append(aVals, aVal)
+				}
+			}
+
+			return f(aVals...), nil
+		})
+}
+
+// Maker1Variadic returns a MakerInfo for functions taking a leading
+// argument, which will be converted by the first ArgDecoder, followed by any
+// number of arguments, all of which will be converted by the second.
+func Maker1Variadic[T, A, V any](
+	funcs map[string]func(A, ...V) check.ValCk[T],
+	a ArgDecoder[A],
+	v ArgDecoder[V],
+) MakerInfo[T] {
+	return mkMakerInfo(funcs, []string{a.Name, variadicArgMarker, v.Name},
+		func(e *ast.CallExpr, f func(A, ...V) check.ValCk[T]) (
+			check.ValCk[T], error,
+		) {
+			if err := checkMinArgCount(e, 1); err != nil {
+				return nil, err
+			}
+
+			aVal, err := a.Decode(e, 0)
+			if err != nil {
+				return nil, err
+			}
+
+			vVals := make([]V, 0, len(e.Args)-1)
+
+			for i := 1; i < len(e.Args); i++ {
+				vVal, err := v.Decode(e, i)
+				if err != nil {
+					return nil, err
+				}
+
+				vVals = append(vVals, vVal)
+			}
+
+			return f(aVal, vVals...), nil
+		})
+}
+
+// MakerChecker returns a MakerInfo for functions taking a single check func
+// of type U which will be generated by the Parser registered for the
+// checker name.
+func MakerChecker[T, U any](
+	funcs map[string]func(check.ValCk[U]) check.ValCk[T],
+	checkerName string,
+) MakerInfo[T] {
+	return Maker1(funcs, CheckerArg[U](checkerName))
+}
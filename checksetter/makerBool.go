@@ -0,0 +1,78 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter
+
+import (
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// BoolCheckerName is the value to use to select the Parser to use when
+// creating checkers for bool values
+const BoolCheckerName = "bool-checker"
+
+var bMaker = MakerNoArgs(
+	map[string]check.ValCk[bool]{
+		"OK":      check.ValOK[bool],
+		"IsTrue":  boolIsTrue,
+		"IsFalse": boolIsFalse,
+	})
+
+var bMakerBcheckerStr = Maker2(
+	map[string]func(check.ValCk[bool], string) check.ValCk[bool]{
+		"Not": check.Not[bool],
+	},
+	CheckerArg[bool](BoolCheckerName), StringArg)
+
+var bMakerMultiBchecker = MakerVariadic(
+	map[string]func(...check.ValCk[bool]) check.ValCk[bool]{
+		"And":  check.And[bool],
+		"Or":   check.Or[bool],
+		"Xor":  valXor[bool],
+		"None": valNone[bool],
+	},
+	CheckerArg[bool](BoolCheckerName))
+
+var bMakerCntMultiBchecker = Maker1Variadic(
+	map[string]func(int, ...check.ValCk[bool]) check.ValCk[bool]{
+		"AtLeast": valAtLeast[bool],
+		"AtMost":  valAtMost[bool],
+		"Exactly": valExactly[bool],
+	},
+	CountArg, CheckerArg[bool](BoolCheckerName))
+
+var bMakerBcheckerBchecker = Maker2(
+	map[string]func(check.ValCk[bool], check.ValCk[bool]) check.ValCk[bool]{
+		"If":      valIf[bool],
+		"Implies": valImplies[bool],
+	},
+	CheckerArg[bool](BoolCheckerName), CheckerArg[bool](BoolCheckerName))
+
+var bMakerBcheckerBcheckerBchecker = Maker3(
+	map[string]func(check.ValCk[bool], check.ValCk[bool], check.ValCk[bool]) check.ValCk[bool]{
+		"IfElse": valIfElse[bool],
+	},
+	CheckerArg[bool](BoolCheckerName), CheckerArg[bool](BoolCheckerName), CheckerArg[bool](BoolCheckerName))
+
+func init() {
+	_, err := MakeParser(
+		BoolCheckerName,
+		map[string]MakerInfo[bool]{
+			"OK":      bMaker,
+			"IsTrue":  bMaker,
+			"IsFalse": bMaker,
+			"Not":     bMakerBcheckerStr,
+			"And":     bMakerMultiBchecker,
+			"Or":      bMakerMultiBchecker,
+			"Xor":     bMakerMultiBchecker,
+			"None":    bMakerMultiBchecker,
+			"AtLeast": bMakerCntMultiBchecker,
+			"AtMost":  bMakerCntMultiBchecker,
+			"Exactly": bMakerCntMultiBchecker,
+			"If":      bMakerBcheckerBchecker,
+			"Implies": bMakerBcheckerBchecker,
+			"IfElse":  bMakerBcheckerBcheckerBchecker,
+		})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,187 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerBool(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[bool](
+		checksetter.BoolCheckerName)
+
+	expArgs := map[string][]string{
+		"OK":      {},
+		"IsTrue":  {},
+		"IsFalse": {},
+		"Not":     {"bool-checker", "string"},
+		"And":     {"...", "bool-checker"},
+		"Or":      {"...", "bool-checker"},
+		"Xor":     {"...", "bool-checker"},
+		"None":    {"...", "bool-checker"},
+		"AtLeast": {"count", "...", "bool-checker"},
+		"AtMost":  {"count", "...", "bool-checker"},
+		"Exactly": {"count", "...", "bool-checker"},
+		"If":      {"bool-checker", "bool-checker"},
+		"Implies": {"bool-checker", "bool-checker"},
+		"IfElse":  {"bool-checker", "bool-checker", "bool-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"bool-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"AtLeast",
+			"AtMost",
+			"Exactly",
+			"If",
+			"IfElse",
+			"Implies",
+			"IsFalse",
+			"IsTrue",
+			"None",
+			"Not",
+			"OK",
+			"Or",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "bool-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsTrue: good: no call"),
+			expr: "IsTrue",
+		},
+		{
+			ID:   testhelper.MkID("IsTrue: good"),
+			expr: "IsTrue()",
+		},
+		{
+			ID: testhelper.MkID("IsTrue: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsTrue():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsTrue(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsFalse: good: no call"),
+			expr: "IsFalse",
+		},
+		{
+			ID:   testhelper.MkID("IsFalse: good"),
+			expr: "IsFalse()",
+		},
+		{
+			ID: testhelper.MkID("IsFalse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsFalse():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsFalse(1)",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(bool-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(bool-checker, bool-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(bool-checker, bool-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(bool-checker, bool-checker, bool-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,107 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter
+
+import (
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// DurationCheckerName is the value to use to select the Parser to use when
+// creating checkers for time.Duration values
+const DurationCheckerName = "duration-checker"
+
+var durMaker = MakerNoArgs(
+	map[string]check.ValCk[time.Duration]{
+		"OK": check.ValOK[time.Duration],
+	})
+
+var durMakerDur = Maker1(
+	map[string]func(time.Duration) check.ValCk[time.Duration]{
+		"EQ": check.ValEQ[time.Duration],
+		"GT": check.ValGT[time.Duration],
+		"GE": check.ValGE[time.Duration],
+		"LT": check.ValLT[time.Duration],
+		"LE": check.ValLE[time.Duration],
+	},
+	DurationArg)
+
+var durMakerDurDur = Maker2(
+	map[string]func(time.Duration, time.Duration) check.ValCk[time.Duration]{
+		"Between": check.ValBetween[time.Duration],
+	},
+	DurationArg, DurationArg)
+
+var durMakerDurcheckerStr = Maker2(
+	map[string]func(check.ValCk[time.Duration], string) check.ValCk[time.Duration]{
+		"Not": check.Not[time.Duration],
+	},
+	CheckerArg[time.Duration](DurationCheckerName), StringArg)
+
+var durMakerMultiDur = MakerVariadic(
+	map[string]func(...time.Duration) check.ValCk[time.Duration]{
+		"OneOf":  valOneOf[time.Duration],
+		"NoneOf": valNoneOf[time.Duration],
+	},
+	DurationArg)
+
+var durMakerMultiDurchecker = MakerVariadic(
+	map[string]func(...check.ValCk[time.Duration]) check.ValCk[time.Duration]{
+		"And":  check.And[time.Duration],
+		"Or":   check.Or[time.Duration],
+		"Xor":  valXor[time.Duration],
+		"None": valNone[time.Duration],
+	},
+	CheckerArg[time.Duration](DurationCheckerName))
+
+var durMakerCntMultiDurchecker = Maker1Variadic(
+	map[string]func(int, ...check.ValCk[time.Duration]) check.ValCk[time.Duration]{
+		"AtLeast": valAtLeast[time.Duration],
+		"AtMost":  valAtMost[time.Duration],
+		"Exactly": valExactly[time.Duration],
+	},
+	CountArg, CheckerArg[time.Duration](DurationCheckerName))
+
+var durMakerDurcheckerDurchecker = Maker2(
+	map[string]func(check.ValCk[time.Duration], check.ValCk[time.Duration]) check.ValCk[time.Duration]{
+		"If":      valIf[time.Duration],
+		"Implies": valImplies[time.Duration],
+	},
+	CheckerArg[time.Duration](DurationCheckerName), CheckerArg[time.Duration](DurationCheckerName))
+
+var durMakerDurcheckerDurcheckerDurchecker = Maker3(
+	map[string]func(check.ValCk[time.Duration], check.ValCk[time.Duration], check.ValCk[time.Duration]) check.ValCk[time.Duration]{
+		"IfElse": valIfElse[time.Duration],
+	},
+	CheckerArg[time.Duration](DurationCheckerName), CheckerArg[time.Duration](DurationCheckerName), CheckerArg[time.Duration](DurationCheckerName))
+
+func init() {
+	_, err := MakeParser(
+		DurationCheckerName,
+		map[string]MakerInfo[time.Duration]{
+			"OK":      durMaker,
+			"EQ":      durMakerDur,
+			"GT":      durMakerDur,
+			"GE":      durMakerDur,
+			"LT":      durMakerDur,
+			"LE":      durMakerDur,
+			"Between": durMakerDurDur,
+			"Not":     durMakerDurcheckerStr,
+			"OneOf":   durMakerMultiDur,
+			"NoneOf":  durMakerMultiDur,
+			"And":     durMakerMultiDurchecker,
+			"Or":      durMakerMultiDurchecker,
+			"Xor":     durMakerMultiDurchecker,
+			"None":    durMakerMultiDurchecker,
+			"AtLeast": durMakerCntMultiDurchecker,
+			"AtMost":  durMakerCntMultiDurchecker,
+			"Exactly": durMakerCntMultiDurchecker,
+			"If":      durMakerDurcheckerDurchecker,
+			"Implies": durMakerDurcheckerDurchecker,
+			"IfElse":  durMakerDurcheckerDurcheckerDurchecker,
+		})
+	if err != nil {
+		panic(err)
+	}
+}
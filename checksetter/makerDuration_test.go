@@ -0,0 +1,240 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerDuration(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[time.Duration](
+		checksetter.DurationCheckerName)
+
+	expArgs := map[string][]string{
+		"OK":      {},
+		"EQ":      {"duration"},
+		"GT":      {"duration"},
+		"GE":      {"duration"},
+		"LT":      {"duration"},
+		"LE":      {"duration"},
+		"Between": {"duration", "duration"},
+		"Not":     {"duration-checker", "string"},
+		"OneOf":   {"...", "duration"},
+		"NoneOf":  {"...", "duration"},
+		"And":     {"...", "duration-checker"},
+		"Or":      {"...", "duration-checker"},
+		"Xor":     {"...", "duration-checker"},
+		"None":    {"...", "duration-checker"},
+		"AtLeast": {"count", "...", "duration-checker"},
+		"AtMost":  {"count", "...", "duration-checker"},
+		"Exactly": {"count", "...", "duration-checker"},
+		"If":      {"duration-checker", "duration-checker"},
+		"Implies": {"duration-checker", "duration-checker"},
+		"IfElse":  {"duration-checker", "duration-checker", "duration-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"duration-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"AtLeast",
+			"AtMost",
+			"Between",
+			"EQ",
+			"Exactly",
+			"GE",
+			"GT",
+			"If",
+			"IfElse",
+			"Implies",
+			"LE",
+			"LT",
+			"None",
+			"NoneOf",
+			"Not",
+			"OK",
+			"OneOf",
+			"Or",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "duration-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("EQ: good"),
+			expr: "EQ(\"1s\")",
+		},
+		{
+			ID: testhelper.MkID("EQ: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("EQ(duration):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "EQ(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("GT: good"),
+			expr: "GT(\"1s\")",
+		},
+		{
+			ID: testhelper.MkID("GT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GT(duration):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GT(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("GE: good"),
+			expr: "GE(\"1s\")",
+		},
+		{
+			ID: testhelper.MkID("GE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GE(duration):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GE(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("LT: good"),
+			expr: "LT(\"1s\")",
+		},
+		{
+			ID: testhelper.MkID("LT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LT(duration):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LT(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("LE: good"),
+			expr: "LE(\"1s\")",
+		},
+		{
+			ID: testhelper.MkID("LE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LE(duration):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LE(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("Between: good"),
+			expr: "Between(\"1s\", \"2s\")",
+		},
+		{
+			ID: testhelper.MkID("Between: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Between(duration, duration):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Between(\"1s\", \"2s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(duration-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("OneOf: good"),
+			expr: "OneOf(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("NoneOf: good"),
+			expr: "NoneOf(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(duration-checker, duration-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(duration-checker, duration-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(duration-checker, duration-checker, duration-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,311 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerFloat64(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[float64](
+		checksetter.Float64CheckerName)
+
+	expArgs := map[string][]string{
+		"OK":          {},
+		"IsFinite":    {},
+		"NotNaN":      {},
+		"IsIntegral":  {},
+		"GT":          {"float64"},
+		"GE":          {"float64"},
+		"LT":          {"float64"},
+		"LE":          {"float64"},
+		"Between":     {"float64", "float64"},
+		"ApproxEQ":    {"float64", "float64"},
+		"RelApproxEQ": {"float64", "float64"},
+		"Sign":        {"int-checker"},
+		"Not":         {"float64-checker", "string"},
+		"OneOf":       {"...", "float64"},
+		"NoneOf":      {"...", "float64"},
+		"And":         {"...", "float64-checker"},
+		"Or":          {"...", "float64-checker"},
+		"Xor":         {"...", "float64-checker"},
+		"None":        {"...", "float64-checker"},
+		"AtLeast":     {"count", "...", "float64-checker"},
+		"AtMost":      {"count", "...", "float64-checker"},
+		"Exactly":     {"count", "...", "float64-checker"},
+		"If":          {"float64-checker", "float64-checker"},
+		"Implies":     {"float64-checker", "float64-checker"},
+		"IfElse":      {"float64-checker", "float64-checker", "float64-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"float64-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"ApproxEQ",
+			"AtLeast",
+			"AtMost",
+			"Between",
+			"Exactly",
+			"GE",
+			"GT",
+			"If",
+			"IfElse",
+			"Implies",
+			"IsFinite",
+			"IsIntegral",
+			"LE",
+			"LT",
+			"None",
+			"NoneOf",
+			"Not",
+			"NotNaN",
+			"OK",
+			"OneOf",
+			"Or",
+			"RelApproxEQ",
+			"Sign",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "float64-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsFinite: good: no call"),
+			expr: "IsFinite",
+		},
+		{
+			ID:   testhelper.MkID("IsFinite: good"),
+			expr: "IsFinite()",
+		},
+		{
+			ID: testhelper.MkID("IsFinite: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsFinite():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsFinite(1)",
+		},
+		{
+			ID:   testhelper.MkID("NotNaN: good: no call"),
+			expr: "NotNaN",
+		},
+		{
+			ID:   testhelper.MkID("NotNaN: good"),
+			expr: "NotNaN()",
+		},
+		{
+			ID: testhelper.MkID("NotNaN: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("NotNaN():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "NotNaN(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsIntegral: good: no call"),
+			expr: "IsIntegral",
+		},
+		{
+			ID:   testhelper.MkID("IsIntegral: good"),
+			expr: "IsIntegral()",
+		},
+		{
+			ID: testhelper.MkID("IsIntegral: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsIntegral():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsIntegral(1)",
+		},
+		{
+			ID:   testhelper.MkID("GT: good"),
+			expr: "GT(1.5)",
+		},
+		{
+			ID: testhelper.MkID("GT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GT(float64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GT(1.5, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("GE: good"),
+			expr: "GE(1.5)",
+		},
+		{
+			ID: testhelper.MkID("GE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GE(float64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GE(1.5, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("LT: good"),
+			expr: "LT(1.5)",
+		},
+		{
+			ID: testhelper.MkID("LT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LT(float64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LT(1.5, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("LE: good"),
+			expr: "LE(1.5)",
+		},
+		{
+			ID: testhelper.MkID("LE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LE(float64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LE(1.5, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("Between: good"),
+			expr: "Between(1, 2)",
+		},
+		{
+			ID: testhelper.MkID("Between: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Between(float64, float64):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Between(1, 2, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("ApproxEQ: good"),
+			expr: "ApproxEQ(1, 0.1)",
+		},
+		{
+			ID: testhelper.MkID("ApproxEQ: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("ApproxEQ(float64, float64):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "ApproxEQ(1, 0.1, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("RelApproxEQ: good"),
+			expr: "RelApproxEQ(1, 0.1)",
+		},
+		{
+			ID: testhelper.MkID("RelApproxEQ: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("RelApproxEQ(float64, float64):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "RelApproxEQ(1, 0.1, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("Sign: good"),
+			expr: "Sign(OK)",
+		},
+		{
+			ID: testhelper.MkID("Sign: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Sign(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "Sign(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(float64-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("OneOf: good"),
+			expr: "OneOf(1.5, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("NoneOf: good"),
+			expr: "NoneOf(1.5, 1.5)",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(float64-checker, float64-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(float64-checker, float64-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(float64-checker, float64-checker, float64-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
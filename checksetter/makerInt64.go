@@ -1,10 +1,8 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
 package checksetter
 
 import (
-	"fmt"
-	"go/ast"
-	"strings"
-
 	"github.com/nickwells/check.mod/v2/check"
 )
 
@@ -12,233 +10,100 @@ import (
 // creating checkers for int64 values
 const Int64CheckerName = "int64-checker"
 
-var (
-	i64MakerArgs = []string{}
-	i64Maker     = MakerInfo[int64]{
-		Args: i64MakerArgs,
-
-		MF: func(e *ast.CallExpr, fName string) (
-			cf check.ValCk[int64], err error,
-		) {
-			funcs := map[string]check.ValCk[int64]{
-				"OK": check.ValOK[int64],
-			}
-
-			maker, ok := funcs[fName]
-			if !ok {
-				return nil, fmt.Errorf(errFmtUnknownFunc, fName)
-			}
-
-			defer func() {
-				if err != nil {
-					err = fmt.Errorf("%s(%s): %w",
-						fName, strings.Join(i64MakerArgs, ", "), err)
-				}
-			}()
-			defer func() {
-				if r := recover(); r != nil {
-					cf = nil
-					err = fmt.Errorf("%v", r)
-				}
-			}()
-
-			if e != nil {
-				if err = checkArgCount(e, 0); err != nil {
-					return nil, err
-				}
-			}
-
-			return maker, nil
-		},
+var i64Maker = MakerNoArgs(
+	map[string]check.ValCk[int64]{
+		"OK": check.ValOK[int64],
+	})
+
+var i64MakerI64 = Maker1(
+	map[string]func(int64) check.ValCk[int64]{
+		"EQ":          check.ValEQ[int64],
+		"GT":          check.ValGT[int64],
+		"GE":          check.ValGE[int64],
+		"LT":          check.ValLT[int64],
+		"LE":          check.ValLE[int64],
+		"Divides":     check.ValDivides[int64],
+		"IsAMultiple": check.ValIsAMultiple[int64],
+	},
+	Int64Arg)
+
+var i64MakerI64I64 = Maker2(
+	map[string]func(int64, int64) check.ValCk[int64]{
+		"Between": check.ValBetween[int64],
+	},
+	Int64Arg, Int64Arg)
+
+var i64MakerI64checkerStr = Maker2(
+	map[string]func(check.ValCk[int64], string) check.ValCk[int64]{
+		"Not": check.Not[int64],
+	},
+	CheckerArg[int64](Int64CheckerName), StringArg)
+
+var i64MakerMultiI64 = MakerVariadic(
+	map[string]func(...int64) check.ValCk[int64]{
+		"OneOf":  valOneOf[int64],
+		"NoneOf": valNoneOf[int64],
+	},
+	Int64Arg)
+
+var i64MakerMultiI64checker = MakerVariadic(
+	map[string]func(...check.ValCk[int64]) check.ValCk[int64]{
+		"And":  check.And[int64],
+		"Or":   check.Or[int64],
+		"Xor":  valXor[int64],
+		"None": valNone[int64],
+	},
+	CheckerArg[int64](Int64CheckerName))
+
+var i64MakerCntMultiI64checker = Maker1Variadic(
+	map[string]func(int, ...check.ValCk[int64]) check.ValCk[int64]{
+		"AtLeast": valAtLeast[int64],
+		"AtMost":  valAtMost[int64],
+		"Exactly": valExactly[int64],
+	},
+	CountArg, CheckerArg[int64](Int64CheckerName))
+
+var i64MakerI64checkerI64checker = Maker2(
+	map[string]func(check.ValCk[int64], check.ValCk[int64]) check.ValCk[int64]{
+		"If":      valIf[int64],
+		"Implies": valImplies[int64],
+	},
+	CheckerArg[int64](Int64CheckerName), CheckerArg[int64](Int64CheckerName))
+
+var i64MakerI64checkerI64checkerI64checker = Maker3(
+	map[string]func(check.ValCk[int64], check.ValCk[int64], check.ValCk[int64]) check.ValCk[int64]{
+		"IfElse": valIfElse[int64],
+	},
+	CheckerArg[int64](Int64CheckerName), CheckerArg[int64](Int64CheckerName), CheckerArg[int64](Int64CheckerName))
+
+func init() {
+	_, err := MakeParser(
+		Int64CheckerName,
+		map[string]MakerInfo[int64]{
+			"OK":          i64Maker,
+			"EQ":          i64MakerI64,
+			"GT":          i64MakerI64,
+			"GE":          i64MakerI64,
+			"LT":          i64MakerI64,
+			"LE":          i64MakerI64,
+			"Divides":     i64MakerI64,
+			"IsAMultiple": i64MakerI64,
+			"Between":     i64MakerI64I64,
+			"Not":         i64MakerI64checkerStr,
+			"OneOf":       i64MakerMultiI64,
+			"NoneOf":      i64MakerMultiI64,
+			"And":         i64MakerMultiI64checker,
+			"Or":          i64MakerMultiI64checker,
+			"Xor":         i64MakerMultiI64checker,
+			"None":        i64MakerMultiI64checker,
+			"AtLeast":     i64MakerCntMultiI64checker,
+			"AtMost":      i64MakerCntMultiI64checker,
+			"Exactly":     i64MakerCntMultiI64checker,
+			"If":          i64MakerI64checkerI64checker,
+			"Implies":     i64MakerI64checkerI64checker,
+			"IfElse":      i64MakerI64checkerI64checkerI64checker,
+		})
+	if err != nil {
+		panic(err)
 	}
-)
-
-var (
-	i64MakerI64Args = []string{"int64"}
-	i64MakerI64     = MakerInfo[int64]{
-		Args: i64MakerI64Args,
-
-		MF: func(e *ast.CallExpr, fName string) (
-			cf check.ValCk[int64], err error,
-		) {
-			funcs := map[string]func(int64) check.ValCk[int64]{
-				"EQ":          check.ValEQ[int64],
-				"GT":          check.ValGT[int64],
-				"GE":          check.ValGE[int64],
-				"LT":          check.ValLT[int64],
-				"LE":          check.ValLE[int64],
-				"Divides":     check.ValDivides[int64],
-				"IsAMultiple": check.ValIsAMultiple[int64],
-			}
-
-			maker, ok := funcs[fName]
-			if !ok {
-				return nil, fmt.Errorf(errFmtUnknownFunc, fName)
-			}
-
-			defer func() {
-				if err != nil {
-					err = fmt.Errorf("%s(%s): %w",
-						fName, strings.Join(i64MakerI64Args, ", "), err)
-				}
-			}()
-			defer func() {
-				if r := recover(); r != nil {
-					cf = nil
-					err = fmt.Errorf("%v", r)
-				}
-			}()
-
-			if err = checkArgCount(e, 1); err != nil {
-				return nil, err
-			}
-
-			i, err := getInt64(e.Args[0])
-			if err != nil {
-				return nil, err
-			}
-
-			return maker(i), nil
-		},
-	}
-)
-
-var (
-	i64MakerI64I64Args = []string{"int64", "int64"}
-	i64MakerI64I64     = MakerInfo[int64]{
-		Args: i64MakerI64I64Args,
-
-		MF: func(e *ast.CallExpr, fName string) (
-			cf check.ValCk[int64], err error,
-		) {
-			funcs := map[string]func(int64, int64) check.ValCk[int64]{
-				"Between": check.ValBetween[int64],
-			}
-
-			maker, ok := funcs[fName]
-			if !ok {
-				return nil, fmt.Errorf(errFmtUnknownFunc, fName)
-			}
-
-			defer func() {
-				if err != nil {
-					err = fmt.Errorf("%s(%s): %w",
-						fName, strings.Join(i64MakerI64I64Args, ", "), err)
-				}
-			}()
-			defer func() {
-				if r := recover(); r != nil {
-					cf = nil
-					err = fmt.Errorf("%v", r)
-				}
-			}()
-
-			if err = checkArgCount(e, 2); err != nil { //nolint:mnd
-				return nil, err
-			}
-
-			i1, err := getInt64(e.Args[0])
-			if err != nil {
-				return nil, err
-			}
-
-			i2, err := getInt64(e.Args[1])
-			if err != nil {
-				return nil, err
-			}
-
-			return maker(i1, i2), nil
-		},
-	}
-)
-
-var (
-	i64MakerI64checkerStringArgs = []string{Int64CheckerName, "string"}
-	i64MakerI64checkerString     = MakerInfo[int64]{
-		Args: i64MakerI64checkerStringArgs,
-
-		MF: func(e *ast.CallExpr, fName string) (
-			cf check.ValCk[int64], err error,
-		) {
-			funcs := map[string]func(check.ValCk[int64], string) check.ValCk[int64]{
-				"Not": check.Not[int64],
-			}
-
-			maker, ok := funcs[fName]
-			if !ok {
-				return nil, fmt.Errorf(errFmtUnknownFunc, fName)
-			}
-
-			defer func() {
-				if err != nil {
-					err = fmt.Errorf("%s(%s): %w",
-						fName, strings.Join(i64MakerI64checkerStringArgs, ", "), err)
-				}
-			}()
-			defer func() {
-				if r := recover(); r != nil {
-					cf = nil
-					err = fmt.Errorf("%v", r)
-				}
-			}()
-
-			if err = checkArgCount(e, 2); err != nil { //nolint:mnd
-				return nil, err
-			}
-
-			ckFunc, err := getCheckFunc[int64](e, 0, Int64CheckerName)
-			if err != nil {
-				return nil, err
-			}
-
-			s, err := getString(e.Args[1])
-			if err != nil {
-				return nil, err
-			}
-
-			return maker(ckFunc, s), nil
-		},
-	}
-)
-
-var (
-	i64MakerMultiI64checkerArgs = []string{"...", Int64CheckerName}
-	i64MakerMultiI64checker     = MakerInfo[int64]{
-		Args: i64MakerMultiI64checkerArgs,
-
-		MF: func(e *ast.CallExpr, fName string) (
-			cf check.ValCk[int64], err error,
-		) {
-			funcs := map[string]func(...check.ValCk[int64]) check.ValCk[int64]{
-				"And": check.And[int64],
-				"Or":  check.Or[int64],
-			}
-
-			maker, ok := funcs[fName]
-			if !ok {
-				return nil, fmt.Errorf(errFmtUnknownFunc, fName)
-			}
-
-			defer func() {
-				if err != nil {
-					err = fmt.Errorf("%s(%s): %w",
-						fName,
-						strings.Join(i64MakerMultiI64checkerArgs, ", "), err)
-				}
-			}()
-			defer func() {
-				if r := recover(); r != nil {
-					cf = nil
-					err = fmt.Errorf("%v", r)
-				}
-			}()
-
-			checkFuncs, err := getCheckFuncs[int64](e, Int64CheckerName)
-			if err != nil {
-				return nil, err
-			}
-
-			return maker(checkFuncs...), nil
-		},
-	}
-)
+}
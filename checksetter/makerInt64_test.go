@@ -0,0 +1,263 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerInt64(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[int64](
+		checksetter.Int64CheckerName)
+
+	expArgs := map[string][]string{
+		"OK":          {},
+		"EQ":          {"int64"},
+		"GT":          {"int64"},
+		"GE":          {"int64"},
+		"LT":          {"int64"},
+		"LE":          {"int64"},
+		"Divides":     {"int64"},
+		"IsAMultiple": {"int64"},
+		"Between":     {"int64", "int64"},
+		"Not":         {"int64-checker", "string"},
+		"OneOf":       {"...", "int64"},
+		"NoneOf":      {"...", "int64"},
+		"And":         {"...", "int64-checker"},
+		"Or":          {"...", "int64-checker"},
+		"Xor":         {"...", "int64-checker"},
+		"None":        {"...", "int64-checker"},
+		"AtLeast":     {"count", "...", "int64-checker"},
+		"AtMost":      {"count", "...", "int64-checker"},
+		"Exactly":     {"count", "...", "int64-checker"},
+		"If":          {"int64-checker", "int64-checker"},
+		"Implies":     {"int64-checker", "int64-checker"},
+		"IfElse":      {"int64-checker", "int64-checker", "int64-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"int64-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"AtLeast",
+			"AtMost",
+			"Between",
+			"Divides",
+			"EQ",
+			"Exactly",
+			"GE",
+			"GT",
+			"If",
+			"IfElse",
+			"Implies",
+			"IsAMultiple",
+			"LE",
+			"LT",
+			"None",
+			"NoneOf",
+			"Not",
+			"OK",
+			"OneOf",
+			"Or",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "int64-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("EQ: good"),
+			expr: "EQ(1)",
+		},
+		{
+			ID: testhelper.MkID("EQ: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("EQ(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "EQ(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("GT: good"),
+			expr: "GT(1)",
+		},
+		{
+			ID: testhelper.MkID("GT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GT(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GT(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("GE: good"),
+			expr: "GE(1)",
+		},
+		{
+			ID: testhelper.MkID("GE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GE(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GE(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("LT: good"),
+			expr: "LT(1)",
+		},
+		{
+			ID: testhelper.MkID("LT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LT(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LT(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("LE: good"),
+			expr: "LE(1)",
+		},
+		{
+			ID: testhelper.MkID("LE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LE(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LE(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("Divides: good"),
+			expr: "Divides(1)",
+		},
+		{
+			ID: testhelper.MkID("Divides: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Divides(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "Divides(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("IsAMultiple: good"),
+			expr: "IsAMultiple(1)",
+		},
+		{
+			ID: testhelper.MkID("IsAMultiple: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsAMultiple(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "IsAMultiple(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("Between: good"),
+			expr: "Between(1, 2)",
+		},
+		{
+			ID: testhelper.MkID("Between: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Between(int64, int64):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Between(1, 2, 1)",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(int64-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("OneOf: good"),
+			expr: "OneOf(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("NoneOf: good"),
+			expr: "NoneOf(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(int64-checker, int64-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(int64-checker, int64-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(int64-checker, int64-checker, int64-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
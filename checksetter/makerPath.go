@@ -0,0 +1,112 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter
+
+import (
+	"io/fs"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// PathCheckerName is the value to use to select the Parser to use when
+// creating checkers for the names of file-system objects
+const PathCheckerName = "path-checker"
+
+var pathMaker = MakerNoArgs(
+	map[string]check.ValCk[string]{
+		"OK":        check.ValOK[string],
+		"Exists":    pathExists,
+		"NotExists": pathNotExists,
+		"IsDir":     pathIsDir,
+		"IsRegular": pathIsRegular,
+		"IsSymlink": pathIsSymlink,
+		"Readable":  pathReadable,
+		"Writable":  pathWritable,
+	})
+
+var pathMakerPerm = Maker1(
+	map[string]func(fs.FileMode) check.ValCk[string]{
+		"HasPerm": pathHasPerm,
+	},
+	PermArg)
+
+var pathMakerI64 = Maker1(
+	map[string]func(int64) check.ValCk[string]{
+		"SizeLE": pathSizeLE,
+	},
+	Int64Arg)
+
+var pathMakerDur = Maker1(
+	map[string]func(time.Duration) check.ValCk[string]{
+		"ModifiedWithin": pathModifiedWithin,
+	},
+	DurationArg)
+
+var pathMakerPathcheckerStr = Maker2(
+	map[string]func(check.ValCk[string], string) check.ValCk[string]{
+		"Not": check.Not[string],
+	},
+	CheckerArg[string](PathCheckerName), StringArg)
+
+var pathMakerMultiPathchecker = MakerVariadic(
+	map[string]func(...check.ValCk[string]) check.ValCk[string]{
+		"And":  check.And[string],
+		"Or":   check.Or[string],
+		"Xor":  valXor[string],
+		"None": valNone[string],
+	},
+	CheckerArg[string](PathCheckerName))
+
+var pathMakerCntMultiPathchecker = Maker1Variadic(
+	map[string]func(int, ...check.ValCk[string]) check.ValCk[string]{
+		"AtLeast": valAtLeast[string],
+		"AtMost":  valAtMost[string],
+		"Exactly": valExactly[string],
+	},
+	CountArg, CheckerArg[string](PathCheckerName))
+
+var pathMakerPathcheckerPathchecker = Maker2(
+	map[string]func(check.ValCk[string], check.ValCk[string]) check.ValCk[string]{
+		"If":      valIf[string],
+		"Implies": valImplies[string],
+	},
+	CheckerArg[string](PathCheckerName), CheckerArg[string](PathCheckerName))
+
+var pathMakerPathcheckerPathcheckerPathchecker = Maker3(
+	map[string]func(check.ValCk[string], check.ValCk[string], check.ValCk[string]) check.ValCk[string]{
+		"IfElse": valIfElse[string],
+	},
+	CheckerArg[string](PathCheckerName), CheckerArg[string](PathCheckerName), CheckerArg[string](PathCheckerName))
+
+func init() {
+	_, err := MakeParser(
+		PathCheckerName,
+		map[string]MakerInfo[string]{
+			"OK":             pathMaker,
+			"Exists":         pathMaker,
+			"NotExists":      pathMaker,
+			"IsDir":          pathMaker,
+			"IsRegular":      pathMaker,
+			"IsSymlink":      pathMaker,
+			"Readable":       pathMaker,
+			"Writable":       pathMaker,
+			"HasPerm":        pathMakerPerm,
+			"SizeLE":         pathMakerI64,
+			"ModifiedWithin": pathMakerDur,
+			"Not":            pathMakerPathcheckerStr,
+			"And":            pathMakerMultiPathchecker,
+			"Or":             pathMakerMultiPathchecker,
+			"Xor":            pathMakerMultiPathchecker,
+			"None":           pathMakerMultiPathchecker,
+			"AtLeast":        pathMakerCntMultiPathchecker,
+			"AtMost":         pathMakerCntMultiPathchecker,
+			"Exactly":        pathMakerCntMultiPathchecker,
+			"If":             pathMakerPathcheckerPathchecker,
+			"Implies":        pathMakerPathcheckerPathchecker,
+			"IfElse":         pathMakerPathcheckerPathcheckerPathchecker,
+		})
+	if err != nil {
+		panic(err)
+	}
+}
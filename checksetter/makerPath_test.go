@@ -0,0 +1,303 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerPath(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[string](
+		checksetter.PathCheckerName)
+
+	expArgs := map[string][]string{
+		"OK":             {},
+		"Exists":         {},
+		"NotExists":      {},
+		"IsDir":          {},
+		"IsRegular":      {},
+		"IsSymlink":      {},
+		"Readable":       {},
+		"Writable":       {},
+		"HasPerm":        {"perm"},
+		"SizeLE":         {"int64"},
+		"ModifiedWithin": {"duration"},
+		"Not":            {"path-checker", "string"},
+		"And":            {"...", "path-checker"},
+		"Or":             {"...", "path-checker"},
+		"Xor":            {"...", "path-checker"},
+		"None":           {"...", "path-checker"},
+		"AtLeast":        {"count", "...", "path-checker"},
+		"AtMost":         {"count", "...", "path-checker"},
+		"Exactly":        {"count", "...", "path-checker"},
+		"If":             {"path-checker", "path-checker"},
+		"Implies":        {"path-checker", "path-checker"},
+		"IfElse":         {"path-checker", "path-checker", "path-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"path-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"AtLeast",
+			"AtMost",
+			"Exactly",
+			"Exists",
+			"HasPerm",
+			"If",
+			"IfElse",
+			"Implies",
+			"IsDir",
+			"IsRegular",
+			"IsSymlink",
+			"ModifiedWithin",
+			"None",
+			"Not",
+			"NotExists",
+			"OK",
+			"Or",
+			"Readable",
+			"SizeLE",
+			"Writable",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "path-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("Exists: good: no call"),
+			expr: "Exists",
+		},
+		{
+			ID:   testhelper.MkID("Exists: good"),
+			expr: "Exists()",
+		},
+		{
+			ID: testhelper.MkID("Exists: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Exists():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "Exists(1)",
+		},
+		{
+			ID:   testhelper.MkID("NotExists: good: no call"),
+			expr: "NotExists",
+		},
+		{
+			ID:   testhelper.MkID("NotExists: good"),
+			expr: "NotExists()",
+		},
+		{
+			ID: testhelper.MkID("NotExists: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("NotExists():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "NotExists(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsDir: good: no call"),
+			expr: "IsDir",
+		},
+		{
+			ID:   testhelper.MkID("IsDir: good"),
+			expr: "IsDir()",
+		},
+		{
+			ID: testhelper.MkID("IsDir: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsDir():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsDir(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsRegular: good: no call"),
+			expr: "IsRegular",
+		},
+		{
+			ID:   testhelper.MkID("IsRegular: good"),
+			expr: "IsRegular()",
+		},
+		{
+			ID: testhelper.MkID("IsRegular: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsRegular():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsRegular(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsSymlink: good: no call"),
+			expr: "IsSymlink",
+		},
+		{
+			ID:   testhelper.MkID("IsSymlink: good"),
+			expr: "IsSymlink()",
+		},
+		{
+			ID: testhelper.MkID("IsSymlink: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsSymlink():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsSymlink(1)",
+		},
+		{
+			ID:   testhelper.MkID("Readable: good: no call"),
+			expr: "Readable",
+		},
+		{
+			ID:   testhelper.MkID("Readable: good"),
+			expr: "Readable()",
+		},
+		{
+			ID: testhelper.MkID("Readable: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Readable():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "Readable(1)",
+		},
+		{
+			ID:   testhelper.MkID("Writable: good: no call"),
+			expr: "Writable",
+		},
+		{
+			ID:   testhelper.MkID("Writable: good"),
+			expr: "Writable()",
+		},
+		{
+			ID: testhelper.MkID("Writable: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Writable():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "Writable(1)",
+		},
+		{
+			ID:   testhelper.MkID("HasPerm: good"),
+			expr: "HasPerm(\"0644\")",
+		},
+		{
+			ID: testhelper.MkID("HasPerm: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("HasPerm(perm):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "HasPerm(\"0644\", \"0644\")",
+		},
+		{
+			ID:   testhelper.MkID("SizeLE: good"),
+			expr: "SizeLE(1)",
+		},
+		{
+			ID: testhelper.MkID("SizeLE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SizeLE(int64):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SizeLE(1, 1)",
+		},
+		{
+			ID:   testhelper.MkID("ModifiedWithin: good"),
+			expr: "ModifiedWithin(\"1s\")",
+		},
+		{
+			ID: testhelper.MkID("ModifiedWithin: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("ModifiedWithin(duration):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "ModifiedWithin(\"1s\", \"1s\")",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(path-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(path-checker, path-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(path-checker, path-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(path-checker, path-checker, path-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,213 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerStringSlice(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[[]string](
+		checksetter.StringSliceCheckerName)
+
+	expArgs := map[string][]string{
+		"OK":         {},
+		"NoDups":     {},
+		"Length":     {"int-checker"},
+		"Not":        {"string-slice-checker", "string"},
+		"SliceAny":   {"string-checker", "string"},
+		"SliceAll":   {"string-checker"},
+		"SliceByPos": {"...", "string-checker"},
+		"And":        {"...", "string-slice-checker"},
+		"Or":         {"...", "string-slice-checker"},
+		"Xor":        {"...", "string-slice-checker"},
+		"None":       {"...", "string-slice-checker"},
+		"AtLeast":    {"count", "...", "string-slice-checker"},
+		"AtMost":     {"count", "...", "string-slice-checker"},
+		"Exactly":    {"count", "...", "string-slice-checker"},
+		"If":         {"string-slice-checker", "string-slice-checker"},
+		"Implies":    {"string-slice-checker", "string-slice-checker"},
+		"IfElse":     {"string-slice-checker", "string-slice-checker", "string-slice-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"string-slice-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"AtLeast",
+			"AtMost",
+			"Exactly",
+			"If",
+			"IfElse",
+			"Implies",
+			"Length",
+			"NoDups",
+			"None",
+			"Not",
+			"OK",
+			"Or",
+			"SliceAll",
+			"SliceAny",
+			"SliceByPos",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "string-slice-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("NoDups: good: no call"),
+			expr: "NoDups",
+		},
+		{
+			ID:   testhelper.MkID("NoDups: good"),
+			expr: "NoDups()",
+		},
+		{
+			ID: testhelper.MkID("NoDups: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("NoDups():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "NoDups(1)",
+		},
+		{
+			ID:   testhelper.MkID("Length: good"),
+			expr: "Length(OK)",
+		},
+		{
+			ID: testhelper.MkID("Length: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Length(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "Length(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(string-slice-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("SliceAny: good"),
+			expr: "SliceAny(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("SliceAny: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SliceAny(string-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "SliceAny(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("SliceAll: good"),
+			expr: "SliceAll(OK)",
+		},
+		{
+			ID: testhelper.MkID("SliceAll: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SliceAll(string-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SliceAll(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("SliceByPos: good"),
+			expr: "SliceByPos(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(string-slice-checker, string-slice-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(string-slice-checker, string-slice-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(string-slice-checker, string-slice-checker, string-slice-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
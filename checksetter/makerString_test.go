@@ -0,0 +1,757 @@
+// Code generated by mkchecker; DO NOT EDIT.
+
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestMakerString(t *testing.T) {
+	parser := checksetter.FindParserOrPanic[string](
+		checksetter.StringCheckerName)
+
+	expArgs := map[string][]string{
+		"OK":                 {},
+		"IsUpper":            {},
+		"IsLower":            {},
+		"IsASCII":            {},
+		"IsPrintable":        {},
+		"ValidUTF8":          {},
+		"NoSurroundingSpace": {},
+		"IsIP":               {},
+		"IsIPv4":             {},
+		"IsIPv6":             {},
+		"IsHostname":         {},
+		"IsHostPort":         {},
+		"IsEmail":            {},
+		"IsSemver":           {},
+		"SemverNoPrerelease": {},
+		"EQ":                 {"string"},
+		"GT":                 {"string"},
+		"GE":                 {"string"},
+		"LT":                 {"string"},
+		"LE":                 {"string"},
+		"HasPrefix":          {"string"},
+		"HasSuffix":          {"string"},
+		"Contains":           {"string"},
+		"ContainsAny":        {"string"},
+		"EqualFold":          {"string"},
+		"CharsIn":            {"string"},
+		"Length":             {"int-checker"},
+		"RuneCount":          {"int-checker"},
+		"AsInt":              {"int-checker"},
+		"Port":               {"int-checker"},
+		"SemverMajor":        {"int-checker"},
+		"SemverMinor":        {"int-checker"},
+		"SemverPatch":        {"int-checker"},
+		"AsFloat":            {"float64-checker"},
+		"AsDuration":         {"duration-checker"},
+		"AsBool":             {"bool-checker"},
+		"SemverEQ":           {"semver"},
+		"SemverGT":           {"semver"},
+		"SemverGE":           {"semver"},
+		"SemverLT":           {"semver"},
+		"SemverLE":           {"semver"},
+		"InCIDR":             {"cidr"},
+		"URLHost":            {"string-checker"},
+		"AsPath":             {"path-checker"},
+		"MatchesPattern":     {"regexp", "string"},
+		"Not":                {"string-checker", "string"},
+		"OneOf":              {"...", "string"},
+		"NoneOf":             {"...", "string"},
+		"IsURL":              {"...", "string"},
+		"And":                {"...", "string-checker"},
+		"Or":                 {"...", "string-checker"},
+		"Xor":                {"...", "string-checker"},
+		"None":               {"...", "string-checker"},
+		"AtLeast":            {"count", "...", "string-checker"},
+		"AtMost":             {"count", "...", "string-checker"},
+		"Exactly":            {"count", "...", "string-checker"},
+		"If":                 {"string-checker", "string-checker"},
+		"Implies":            {"string-checker", "string-checker"},
+		"IfElse":             {"string-checker", "string-checker", "string-checker"},
+	}
+
+	testhelper.DiffStringSlice(t,
+		"string-checker", "maker names",
+		parser.Makers(),
+		[]string{
+			"And",
+			"AsBool",
+			"AsDuration",
+			"AsFloat",
+			"AsInt",
+			"AsPath",
+			"AtLeast",
+			"AtMost",
+			"CharsIn",
+			"Contains",
+			"ContainsAny",
+			"EQ",
+			"EqualFold",
+			"Exactly",
+			"GE",
+			"GT",
+			"HasPrefix",
+			"HasSuffix",
+			"If",
+			"IfElse",
+			"Implies",
+			"InCIDR",
+			"IsASCII",
+			"IsEmail",
+			"IsHostPort",
+			"IsHostname",
+			"IsIP",
+			"IsIPv4",
+			"IsIPv6",
+			"IsLower",
+			"IsPrintable",
+			"IsSemver",
+			"IsURL",
+			"IsUpper",
+			"LE",
+			"LT",
+			"Length",
+			"MatchesPattern",
+			"NoSurroundingSpace",
+			"None",
+			"NoneOf",
+			"Not",
+			"OK",
+			"OneOf",
+			"Or",
+			"Port",
+			"RuneCount",
+			"SemverEQ",
+			"SemverGE",
+			"SemverGT",
+			"SemverLE",
+			"SemverLT",
+			"SemverMajor",
+			"SemverMinor",
+			"SemverNoPrerelease",
+			"SemverPatch",
+			"URLHost",
+			"ValidUTF8",
+			"Xor",
+		})
+
+	for name, args := range expArgs {
+		actArgs, err := parser.Args(name)
+		if err != nil {
+			t.Errorf("unexpected error getting the args for %s: %s",
+				name, err)
+
+			continue
+		}
+
+		testhelper.DiffStringSlice(t, "string-checker", "args for "+name,
+			actArgs, args)
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("OK: good: no call"),
+			expr: "OK",
+		},
+		{
+			ID:   testhelper.MkID("OK: good"),
+			expr: "OK()",
+		},
+		{
+			ID: testhelper.MkID("OK: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("OK():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "OK(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsUpper: good: no call"),
+			expr: "IsUpper",
+		},
+		{
+			ID:   testhelper.MkID("IsUpper: good"),
+			expr: "IsUpper()",
+		},
+		{
+			ID: testhelper.MkID("IsUpper: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsUpper():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsUpper(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsLower: good: no call"),
+			expr: "IsLower",
+		},
+		{
+			ID:   testhelper.MkID("IsLower: good"),
+			expr: "IsLower()",
+		},
+		{
+			ID: testhelper.MkID("IsLower: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsLower():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsLower(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsASCII: good: no call"),
+			expr: "IsASCII",
+		},
+		{
+			ID:   testhelper.MkID("IsASCII: good"),
+			expr: "IsASCII()",
+		},
+		{
+			ID: testhelper.MkID("IsASCII: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsASCII():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsASCII(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsPrintable: good: no call"),
+			expr: "IsPrintable",
+		},
+		{
+			ID:   testhelper.MkID("IsPrintable: good"),
+			expr: "IsPrintable()",
+		},
+		{
+			ID: testhelper.MkID("IsPrintable: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsPrintable():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsPrintable(1)",
+		},
+		{
+			ID:   testhelper.MkID("ValidUTF8: good: no call"),
+			expr: "ValidUTF8",
+		},
+		{
+			ID:   testhelper.MkID("ValidUTF8: good"),
+			expr: "ValidUTF8()",
+		},
+		{
+			ID: testhelper.MkID("ValidUTF8: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("ValidUTF8():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "ValidUTF8(1)",
+		},
+		{
+			ID:   testhelper.MkID("NoSurroundingSpace: good: no call"),
+			expr: "NoSurroundingSpace",
+		},
+		{
+			ID:   testhelper.MkID("NoSurroundingSpace: good"),
+			expr: "NoSurroundingSpace()",
+		},
+		{
+			ID: testhelper.MkID("NoSurroundingSpace: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("NoSurroundingSpace():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "NoSurroundingSpace(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsIP: good: no call"),
+			expr: "IsIP",
+		},
+		{
+			ID:   testhelper.MkID("IsIP: good"),
+			expr: "IsIP()",
+		},
+		{
+			ID: testhelper.MkID("IsIP: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsIP():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsIP(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsIPv4: good: no call"),
+			expr: "IsIPv4",
+		},
+		{
+			ID:   testhelper.MkID("IsIPv4: good"),
+			expr: "IsIPv4()",
+		},
+		{
+			ID: testhelper.MkID("IsIPv4: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsIPv4():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsIPv4(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsIPv6: good: no call"),
+			expr: "IsIPv6",
+		},
+		{
+			ID:   testhelper.MkID("IsIPv6: good"),
+			expr: "IsIPv6()",
+		},
+		{
+			ID: testhelper.MkID("IsIPv6: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsIPv6():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsIPv6(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsHostname: good: no call"),
+			expr: "IsHostname",
+		},
+		{
+			ID:   testhelper.MkID("IsHostname: good"),
+			expr: "IsHostname()",
+		},
+		{
+			ID: testhelper.MkID("IsHostname: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsHostname():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsHostname(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsHostPort: good: no call"),
+			expr: "IsHostPort",
+		},
+		{
+			ID:   testhelper.MkID("IsHostPort: good"),
+			expr: "IsHostPort()",
+		},
+		{
+			ID: testhelper.MkID("IsHostPort: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsHostPort():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsHostPort(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsEmail: good: no call"),
+			expr: "IsEmail",
+		},
+		{
+			ID:   testhelper.MkID("IsEmail: good"),
+			expr: "IsEmail()",
+		},
+		{
+			ID: testhelper.MkID("IsEmail: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsEmail():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsEmail(1)",
+		},
+		{
+			ID:   testhelper.MkID("IsSemver: good: no call"),
+			expr: "IsSemver",
+		},
+		{
+			ID:   testhelper.MkID("IsSemver: good"),
+			expr: "IsSemver()",
+		},
+		{
+			ID: testhelper.MkID("IsSemver: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IsSemver():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "IsSemver(1)",
+		},
+		{
+			ID:   testhelper.MkID("SemverNoPrerelease: good: no call"),
+			expr: "SemverNoPrerelease",
+		},
+		{
+			ID:   testhelper.MkID("SemverNoPrerelease: good"),
+			expr: "SemverNoPrerelease()",
+		},
+		{
+			ID: testhelper.MkID("SemverNoPrerelease: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverNoPrerelease():",
+				"the call has 1 arguments, it should have 0"),
+			expr: "SemverNoPrerelease(1)",
+		},
+		{
+			ID:   testhelper.MkID("EQ: good"),
+			expr: "EQ(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("EQ: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("EQ(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "EQ(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("GT: good"),
+			expr: "GT(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("GT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GT(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GT(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("GE: good"),
+			expr: "GE(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("GE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("GE(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "GE(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("LT: good"),
+			expr: "LT(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("LT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LT(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LT(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("LE: good"),
+			expr: "LE(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("LE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("LE(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "LE(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("HasPrefix: good"),
+			expr: "HasPrefix(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("HasPrefix: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("HasPrefix(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "HasPrefix(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("HasSuffix: good"),
+			expr: "HasSuffix(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("HasSuffix: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("HasSuffix(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "HasSuffix(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("Contains: good"),
+			expr: "Contains(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("Contains: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Contains(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "Contains(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("ContainsAny: good"),
+			expr: "ContainsAny(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("ContainsAny: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("ContainsAny(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "ContainsAny(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("EqualFold: good"),
+			expr: "EqualFold(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("EqualFold: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("EqualFold(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "EqualFold(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("CharsIn: good"),
+			expr: "CharsIn(\"a\")",
+		},
+		{
+			ID: testhelper.MkID("CharsIn: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("CharsIn(string):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "CharsIn(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("Length: good"),
+			expr: "Length(OK)",
+		},
+		{
+			ID: testhelper.MkID("Length: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Length(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "Length(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("RuneCount: good"),
+			expr: "RuneCount(OK)",
+		},
+		{
+			ID: testhelper.MkID("RuneCount: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("RuneCount(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "RuneCount(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AsInt: good"),
+			expr: "AsInt(OK)",
+		},
+		{
+			ID: testhelper.MkID("AsInt: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("AsInt(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "AsInt(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Port: good"),
+			expr: "Port(OK)",
+		},
+		{
+			ID: testhelper.MkID("Port: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Port(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "Port(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("SemverMajor: good"),
+			expr: "SemverMajor(OK)",
+		},
+		{
+			ID: testhelper.MkID("SemverMajor: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverMajor(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverMajor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("SemverMinor: good"),
+			expr: "SemverMinor(OK)",
+		},
+		{
+			ID: testhelper.MkID("SemverMinor: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverMinor(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverMinor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("SemverPatch: good"),
+			expr: "SemverPatch(OK)",
+		},
+		{
+			ID: testhelper.MkID("SemverPatch: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverPatch(int-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverPatch(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AsFloat: good"),
+			expr: "AsFloat(OK)",
+		},
+		{
+			ID: testhelper.MkID("AsFloat: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("AsFloat(float64-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "AsFloat(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AsDuration: good"),
+			expr: "AsDuration(OK)",
+		},
+		{
+			ID: testhelper.MkID("AsDuration: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("AsDuration(duration-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "AsDuration(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AsBool: good"),
+			expr: "AsBool(OK)",
+		},
+		{
+			ID: testhelper.MkID("AsBool: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("AsBool(bool-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "AsBool(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("SemverEQ: good"),
+			expr: "SemverEQ(\"1.4.0\")",
+		},
+		{
+			ID: testhelper.MkID("SemverEQ: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverEQ(semver):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverEQ(\"1.4.0\", \"1.4.0\")",
+		},
+		{
+			ID:   testhelper.MkID("SemverGT: good"),
+			expr: "SemverGT(\"1.4.0\")",
+		},
+		{
+			ID: testhelper.MkID("SemverGT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverGT(semver):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverGT(\"1.4.0\", \"1.4.0\")",
+		},
+		{
+			ID:   testhelper.MkID("SemverGE: good"),
+			expr: "SemverGE(\"1.4.0\")",
+		},
+		{
+			ID: testhelper.MkID("SemverGE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverGE(semver):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverGE(\"1.4.0\", \"1.4.0\")",
+		},
+		{
+			ID:   testhelper.MkID("SemverLT: good"),
+			expr: "SemverLT(\"1.4.0\")",
+		},
+		{
+			ID: testhelper.MkID("SemverLT: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverLT(semver):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverLT(\"1.4.0\", \"1.4.0\")",
+		},
+		{
+			ID:   testhelper.MkID("SemverLE: good"),
+			expr: "SemverLE(\"1.4.0\")",
+		},
+		{
+			ID: testhelper.MkID("SemverLE: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("SemverLE(semver):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "SemverLE(\"1.4.0\", \"1.4.0\")",
+		},
+		{
+			ID:   testhelper.MkID("InCIDR: good"),
+			expr: "InCIDR(\"10.0.0.0/8\")",
+		},
+		{
+			ID: testhelper.MkID("InCIDR: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("InCIDR(cidr):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "InCIDR(\"10.0.0.0/8\", \"10.0.0.0/8\")",
+		},
+		{
+			ID:   testhelper.MkID("URLHost: good"),
+			expr: "URLHost(OK)",
+		},
+		{
+			ID: testhelper.MkID("URLHost: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("URLHost(string-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "URLHost(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AsPath: good"),
+			expr: "AsPath(OK)",
+		},
+		{
+			ID: testhelper.MkID("AsPath: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("AsPath(path-checker):",
+				"the call has 2 arguments, it should have 1"),
+			expr: "AsPath(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("MatchesPattern: good"),
+			expr: "MatchesPattern(\"a\", \"a\")",
+		},
+		{
+			ID: testhelper.MkID("MatchesPattern: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("MatchesPattern(regexp, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "MatchesPattern(\"a\", \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("Not: good"),
+			expr: "Not(OK, \"a\")",
+		},
+		{
+			ID: testhelper.MkID("Not: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Not(string-checker, string):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Not(OK, \"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("OneOf: good"),
+			expr: "OneOf(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("NoneOf: good"),
+			expr: "NoneOf(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("IsURL: good"),
+			expr: "IsURL(\"a\", \"a\")",
+		},
+		{
+			ID:   testhelper.MkID("And: good"),
+			expr: "And(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Or: good"),
+			expr: "Or(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Xor: good"),
+			expr: "Xor(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("None: good"),
+			expr: "None(OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtLeast: good"),
+			expr: "AtLeast(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("AtMost: good"),
+			expr: "AtMost(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Exactly: good"),
+			expr: "Exactly(1, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("If: good"),
+			expr: "If(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("If: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("If(string-checker, string-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "If(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("Implies: good"),
+			expr: "Implies(OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("Implies: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("Implies(string-checker, string-checker):",
+				"the call has 3 arguments, it should have 2"),
+			expr: "Implies(OK, OK, OK)",
+		},
+		{
+			ID:   testhelper.MkID("IfElse: good"),
+			expr: "IfElse(OK, OK, OK)",
+		},
+		{
+			ID: testhelper.MkID("IfElse: bad: too many args"),
+			ExpErr: testhelper.MkExpErr("IfElse(string-checker, string-checker, string-checker):",
+				"the call has 4 arguments, it should have 3"),
+			expr: "IfElse(OK, OK, OK, OK)",
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := parser.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
@@ -0,0 +1,266 @@
+package checksetter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// isOdd is a check func used to test the MakerNoArgs func
+func isOdd(v int) error {
+	if v%2 == 0 {
+		return fmt.Errorf("%d is not odd", v)
+	}
+
+	return nil
+}
+
+// inRange is a check func maker used to test the Maker2 func
+func inRange(low, high int) check.ValCk[int] {
+	return check.And(check.ValGE(low), check.ValLE(high))
+}
+
+// atLeastN is a check func maker used to test the Maker1Variadic func
+func atLeastN(n int, cfs ...check.ValCk[int]) check.ValCk[int] {
+	return func(v int) error {
+		passed := 0
+
+		for _, cf := range cfs {
+			if cf(v) == nil {
+				passed++
+			}
+		}
+
+		if passed < n {
+			return fmt.Errorf("%d passed only %d checks", v, passed)
+		}
+
+		return nil
+	}
+}
+
+// choose is a check func maker used to test the Maker3 func
+func choose(cond, a, b check.ValCk[int]) check.ValCk[int] {
+	return func(v int) error {
+		if cond(v) == nil {
+			return a(v)
+		}
+
+		return b(v)
+	}
+}
+
+// lenOf is a check func maker used to test the MakerChecker func
+func lenOf(cf check.ValCk[int]) check.ValCk[int] {
+	return func(v int) error {
+		return cf(len(fmt.Sprint(v)))
+	}
+}
+
+func TestMakerHelpers(t *testing.T) {
+	const checkerName = "TestMakerHelpers"
+
+	p, err := checksetter.MakeParser(checkerName,
+		map[string]checksetter.MakerInfo[int]{
+			"IsOdd": checksetter.MakerNoArgs(
+				map[string]check.ValCk[int]{
+					"IsOdd": isOdd,
+				}),
+			"EQ": checksetter.Maker1(
+				map[string]func(int) check.ValCk[int]{
+					"EQ": check.ValEQ[int],
+				},
+				checksetter.IntArg),
+			"InRange": checksetter.Maker2(
+				map[string]func(int, int) check.ValCk[int]{
+					"InRange": inRange,
+				},
+				checksetter.IntArg, checksetter.IntArg),
+			"Any": checksetter.MakerVariadic(
+				map[string]func(...check.ValCk[int]) check.ValCk[int]{
+					"Any": check.Or[int],
+				},
+				checksetter.CheckerArg[int](checkerName)),
+			"AtLeastN": checksetter.Maker1Variadic(
+				map[string]func(int, ...check.ValCk[int]) check.ValCk[int]{
+					"AtLeastN": atLeastN,
+				},
+				checksetter.IntArg, checksetter.CheckerArg[int](checkerName)),
+			"Choose": checksetter.Maker3(
+				map[string]func(
+					check.ValCk[int], check.ValCk[int], check.ValCk[int],
+				) check.ValCk[int]{
+					"Choose": choose,
+				},
+				checksetter.CheckerArg[int](checkerName),
+				checksetter.CheckerArg[int](checkerName),
+				checksetter.CheckerArg[int](checkerName)),
+			"Digits": checksetter.MakerChecker(
+				map[string]func(check.ValCk[int]) check.ValCk[int]{
+					"Digits": lenOf,
+				},
+				checksetter.IntCheckerName),
+		})
+	if err != nil {
+		t.Fatal("couldn't create the test Parser: " + err.Error())
+	}
+
+	testhelper.DiffStringSlice(t, "MakerFuncs", "Args for Any",
+		p.MakerFuncs()["Any"], []string{"...", checkerName})
+	testhelper.DiffStringSlice(t, "MakerFuncs", "Args for AtLeastN",
+		p.MakerFuncs()["AtLeastN"], []string{"int", "...", checkerName})
+	testhelper.DiffStringSlice(t, "MakerFuncs", "Args for Choose",
+		p.MakerFuncs()["Choose"],
+		[]string{checkerName, checkerName, checkerName})
+	testhelper.DiffStringSlice(t, "MakerFuncs", "Args for Digits",
+		p.MakerFuncs()["Digits"], []string{checksetter.IntCheckerName})
+	testhelper.DiffStringSlice(t, "MakerFuncs", "Args for InRange",
+		p.MakerFuncs()["InRange"], []string{"int", "int"})
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr        string
+		passingVals []int
+		failingVals []int
+	}{
+		{
+			ID:          testhelper.MkID("no args: good"),
+			expr:        "IsOdd",
+			passingVals: []int{1, 3, -5},
+			failingVals: []int{0, 2},
+		},
+		{
+			ID: testhelper.MkID("no args: bad"),
+			ExpErr: testhelper.MkExpErr(
+				"IsOdd(): the call has 1 arguments, it should have 0"),
+			expr: "IsOdd(1)",
+		},
+		{
+			ID:          testhelper.MkID("1 arg: good"),
+			expr:        "EQ(3)",
+			passingVals: []int{3},
+			failingVals: []int{2, 4},
+		},
+		{
+			ID: testhelper.MkID("1 arg: bad, no call"),
+			ExpErr: testhelper.MkExpErr(
+				"EQ(int): the call has 0 arguments, it should have 1"),
+			expr: "EQ",
+		},
+		{
+			ID: testhelper.MkID("1 arg: bad, wrong type"),
+			ExpErr: testhelper.MkExpErr(
+				`EQ(int): "\"a\"" isn't an INT, it's a STRING`),
+			expr: `EQ("a")`,
+		},
+		{
+			ID:          testhelper.MkID("2 args: good"),
+			expr:        "InRange(3, 5)",
+			passingVals: []int{3, 4, 5},
+			failingVals: []int{2, 6},
+		},
+		{
+			ID: testhelper.MkID("2 args: bad, 2nd arg"),
+			ExpErr: testhelper.MkExpErr(
+				`InRange(int, int): "\"a\"" isn't an INT, it's a STRING`),
+			expr: `InRange(3, "a")`,
+		},
+		{
+			ID:          testhelper.MkID("variadic: good"),
+			expr:        "Any(EQ(1), InRange(5, 6), IsOdd)",
+			passingVals: []int{1, 5, 6, 7},
+			failingVals: []int{2, 4, 8},
+		},
+		{
+			ID: testhelper.MkID("variadic: bad"),
+			ExpErr: testhelper.MkExpErr(
+				"Any(..., "+checkerName+"):",
+				"can't convert argument 1 to "+checkerName+":",
+				"nonesuch is an unknown function"),
+			expr: "Any(EQ(1), nonesuch)",
+		},
+		{
+			ID:          testhelper.MkID("1 arg, variadic: good"),
+			expr:        "AtLeastN(2, IsOdd, EQ(3), InRange(1, 5))",
+			passingVals: []int{1, 3, 5},
+			failingVals: []int{2, 7},
+		},
+		{
+			ID:          testhelper.MkID("1 arg, variadic: good, no variadic args"),
+			expr:        "AtLeastN(0)",
+			passingVals: []int{1, 2},
+		},
+		{
+			ID: testhelper.MkID("1 arg, variadic: bad, no args"),
+			ExpErr: testhelper.MkExpErr(
+				"AtLeastN(int, ..., "+checkerName+"):",
+				"the call has 0 arguments, it should have at least 1"),
+			expr: "AtLeastN()",
+		},
+		{
+			ID: testhelper.MkID("1 arg, variadic: bad, variadic arg"),
+			ExpErr: testhelper.MkExpErr(
+				"AtLeastN(int, ..., "+checkerName+"):",
+				"can't convert argument 2 to "+checkerName+":",
+				"nonesuch is an unknown function"),
+			expr: "AtLeastN(1, IsOdd, nonesuch)",
+		},
+		{
+			ID:          testhelper.MkID("3 args: good"),
+			expr:        "Choose(IsOdd, EQ(1), InRange(2, 4))",
+			passingVals: []int{1, 2, 4},
+			failingVals: []int{3, 6},
+		},
+		{
+			ID: testhelper.MkID("3 args: bad, too few"),
+			ExpErr: testhelper.MkExpErr(
+				"Choose("+checkerName+", "+checkerName+", "+checkerName+"):",
+				"the call has 2 arguments, it should have 3"),
+			expr: "Choose(IsOdd, EQ(1))",
+		},
+		{
+			ID:          testhelper.MkID("checker: good"),
+			expr:        "Digits(LT(3))",
+			passingVals: []int{1, 12, 99},
+			failingVals: []int{100, 1234},
+		},
+		{
+			ID: testhelper.MkID("checker: bad"),
+			ExpErr: testhelper.MkExpErr(
+				"Digits("+checksetter.IntCheckerName+"):",
+				"can't convert argument 0 to "+checksetter.IntCheckerName+":",
+				"IsOdd is an unknown function"),
+			expr: "Digits(IsOdd)",
+		},
+	}
+
+	for _, tc := range testCases {
+		vcs, err := p.Parse(tc.expr)
+		if testhelper.CheckExpErr(t, err, tc) && err == nil {
+			if !testhelper.DiffInt(t, tc.IDStr(), "number of ValCk funcs",
+				len(vcs), 1) {
+				continue
+			}
+
+			for _, v := range tc.passingVals {
+				if err := vcs[0](v); err != nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: unexpected error checking %d: %s", v, err)
+					t.Error("\t: Bad check")
+				}
+			}
+
+			for _, v := range tc.failingVals {
+				if err := vcs[0](v); err == nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: missing error checking %d", v)
+					t.Error("\t: Bad check")
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,278 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// namedCheck records the definition of a named check
+type namedCheck struct {
+	src  string
+	expr ast.Expr
+}
+
+// AddNamedCheck binds the name to the check expression. The name can then
+// be used as a check in any expression parsed by this Parser, including
+// those which are nested within checks of other families. It will return
+// an error if the name is already in use, if the expression refers to the
+// name being defined or if the expression is not a valid check.
+func (p Parser[T]) AddNamedCheck(name, checkExpr string) error {
+	if err := p.checkNewName(name); err != nil {
+		return err
+	}
+
+	expr, err := parser.ParseExpr(checkExpr)
+	if err != nil {
+		return fmt.Errorf("bad named check %q: %w", name, err)
+	}
+
+	if refersTo(expr, map[string]bool{name: true}) != "" {
+		return fmt.Errorf("the named check %q is defined recursively: %s",
+			name, name+" -> "+name)
+	}
+
+	if _, err := p.ParseExpr(expr); err != nil {
+		return fmt.Errorf("bad named check %q: %w", name, err)
+	}
+
+	p.namedChecks[name] = namedCheck{src: checkExpr, expr: expr}
+
+	return nil
+}
+
+// NamedChecks returns a map of the names of the named checks to the check
+// expressions they are bound to.
+//
+// This can be used to construct the Allowed Values message for a setter.
+func (p Parser[T]) NamedChecks() map[string]string {
+	nc := make(map[string]string, len(p.namedChecks))
+
+	for k, v := range p.namedChecks {
+		nc[k] = v.src
+	}
+
+	return nc
+}
+
+// checkNewName returns a non-nil error if the name cannot be used for a
+// new named check
+func (p Parser[T]) checkNewName(name string) error {
+	if !token.IsIdentifier(name) {
+		return fmt.Errorf("bad name for a named check: %q"+
+			" (it must be a valid identifier)", name)
+	}
+
+	if _, ok := p.makers[name]; ok {
+		return fmt.Errorf("bad name for a named check: %q"+
+			" (it is already the name of a %s function)",
+			name, p.checkerName)
+	}
+
+	if _, ok := p.namedChecks[name]; ok {
+		return fmt.Errorf("bad name for a named check: %q"+
+			" (it is already a named check)", name)
+	}
+
+	if _, ok := p.constants[name]; ok {
+		return fmt.Errorf("bad name for a named check: %q"+
+			" (it is already a constant)", name)
+	}
+
+	return nil
+}
+
+// refersTo returns the first name from the set of names that is used as an
+// identifier in the expression or the empty string if none are used
+func refersTo(expr ast.Expr, names map[string]bool) string {
+	found := ""
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if found != "" {
+			return false
+		}
+
+		if id, ok := n.(*ast.Ident); ok && names[id.Name] {
+			found = id.Name
+		}
+
+		return true
+	})
+
+	return found
+}
+
+// argKindAt returns the kind of the argument at the given index from the
+// maker args. It will return false if there is no such argument.
+func argKindAt(args []string, idx int) (string, bool) {
+	for i, a := range args {
+		if a == variadicArgMarker {
+			if i+1 < len(args) && idx >= i {
+				return args[i+1], true
+			}
+
+			return "", false
+		}
+
+		if i == idx {
+			return a, true
+		}
+	}
+
+	return "", false
+}
+
+// localDefs holds the named checks defined in the list of checks being
+// parsed. These are only visible within that list.
+type localDefs map[string]ast.Expr
+
+// splitLocalDefs separates the elements of the list of checks into the
+// checks and the named check definitions (given as 'name: check'). It
+// returns an error if any definition is invalid, if any name is defined
+// more than once or if the definitions are recursive.
+func (p Parser[T]) splitLocalDefs(elts []ast.Expr) (
+	[]ast.Expr, localDefs, error,
+) {
+	checks := make([]ast.Expr, 0, len(elts))
+	defs := localDefs{}
+
+	for _, elt := range elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			checks = append(checks, elt)
+			continue
+		}
+
+		id, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			return nil, nil,
+				fmt.Errorf("bad named check definition:"+
+					" the name must be an identifier, not a %T", kv.Key)
+		}
+
+		if _, ok := defs[id.Name]; ok {
+			return nil, nil,
+				fmt.Errorf("bad name for a named check: %q"+
+					" (it is already a named check)", id.Name)
+		}
+
+		if err := p.checkNewName(id.Name); err != nil {
+			return nil, nil, err
+		}
+
+		defs[id.Name] = kv.Value
+	}
+
+	if err := defs.checkRecursion(); err != nil {
+		return nil, nil, err
+	}
+
+	return checks, defs, nil
+}
+
+// checkRecursion returns a non-nil error if any of the definitions refers,
+// directly or indirectly, to itself
+func (defs localDefs) checkRecursion() error {
+	names := make(map[string]bool, len(defs))
+	for k := range defs {
+		names[k] = true
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := map[string]int{}
+
+	var visit func(name string, path []string) error
+
+	visit = func(name string, path []string) error {
+		path = append(path, name)
+
+		switch state[name] {
+		case visiting:
+			start := slices.Index(path, name)
+			return fmt.Errorf("the named check %q is defined recursively: %s",
+				name, strings.Join(path[start:], " -> "))
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+
+		var err error
+
+		ast.Inspect(defs[name], func(n ast.Node) bool {
+			if err != nil {
+				return false
+			}
+
+			if id, ok := n.(*ast.Ident); ok && names[id.Name] {
+				err = visit(id.Name, path)
+			}
+
+			return true
+		})
+
+		state[name] = visited
+
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(defs)) {
+		if err := visit(name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expandLocalDefs returns the expression with any references to the local
+// definitions replaced by the expressions they are bound to. Only those
+// identifiers in positions where a check of this Parser's family is
+// expected are replaced. The original expression is not changed.
+func (p Parser[T]) expandLocalDefs(expr ast.Expr, defs localDefs) ast.Expr {
+	if len(defs) == 0 {
+		return expr
+	}
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if def, ok := defs[e.Name]; ok {
+			return p.expandLocalDefs(def, defs)
+		}
+	case *ast.CallExpr:
+		fID, ok := e.Fun.(*ast.Ident)
+		if !ok {
+			return expr
+		}
+
+		mi, ok := p.makers[fID.Name]
+		if !ok {
+			return expr
+		}
+
+		newCall := *e
+		newCall.Args = make([]ast.Expr, 0, len(e.Args))
+
+		for i, arg := range e.Args {
+			if kind, ok := argKindAt(mi.Args, i); ok &&
+				kind == p.checkerName {
+				arg = p.expandLocalDefs(arg, defs)
+			}
+
+			newCall.Args = append(newCall.Args, arg)
+		}
+
+		return &newCall
+	}
+
+	return expr
+}
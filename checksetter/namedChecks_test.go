@@ -0,0 +1,253 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// mkNamedCheckTestParser creates a Parser for testing the named checks. It
+// is a fatal error if it cannot be created.
+func mkNamedCheckTestParser(t *testing.T, checkerName string) (
+	checksetter.Parser[int], map[string]checksetter.MakerInfo[int],
+) {
+	t.Helper()
+
+	makers := map[string]checksetter.MakerInfo[int]{
+		"OK": checksetter.MakerNoArgs(
+			map[string]check.ValCk[int]{"OK": check.ValOK[int]}),
+		"GE": checksetter.Maker1(
+			map[string]func(int) check.ValCk[int]{"GE": check.ValGE[int]},
+			checksetter.IntArg),
+		"LE": checksetter.Maker1(
+			map[string]func(int) check.ValCk[int]{"LE": check.ValLE[int]},
+			checksetter.IntArg),
+		"EQ": checksetter.Maker1(
+			map[string]func(int) check.ValCk[int]{"EQ": check.ValEQ[int]},
+			checksetter.IntArg),
+		"Not": checksetter.Maker2(
+			map[string]func(check.ValCk[int], string) check.ValCk[int]{
+				"Not": check.Not[int],
+			},
+			checksetter.CheckerArg[int](checkerName), checksetter.StringArg),
+		"And": checksetter.MakerVariadic(
+			map[string]func(...check.ValCk[int]) check.ValCk[int]{
+				"And": check.And[int],
+			},
+			checksetter.CheckerArg[int](checkerName)),
+	}
+
+	p, err := checksetter.MakeParser(checkerName, makers)
+	if err != nil {
+		t.Fatal("couldn't create the test Parser: " + err.Error())
+	}
+
+	return p, makers
+}
+
+func TestAddNamedCheck(t *testing.T) {
+	const checkerName = "TestAddNamedCheck"
+
+	p, makers := mkNamedCheckTestParser(t, checkerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name      string
+		checkExpr string
+	}{
+		{
+			ID:        testhelper.MkID("good"),
+			name:      "validPort",
+			checkExpr: "And(GE(1024), LE(65535))",
+		},
+		{
+			ID:        testhelper.MkID("good: uses another named check"),
+			name:      "notHTTP",
+			checkExpr: `And(validPort, Not(EQ(8080), "the HTTP port"))`,
+		},
+		{
+			ID: testhelper.MkID("bad: duplicate"),
+			ExpErr: testhelper.MkExpErr(
+				`bad name for a named check: "validPort"`,
+				"it is already a named check"),
+			name:      "validPort",
+			checkExpr: "OK",
+		},
+		{
+			ID: testhelper.MkID("bad: maker name"),
+			ExpErr: testhelper.MkExpErr(
+				`bad name for a named check: "GE"`,
+				"it is already the name of a "+checkerName+" function"),
+			name:      "GE",
+			checkExpr: "OK",
+		},
+		{
+			ID: testhelper.MkID("bad: not an identifier"),
+			ExpErr: testhelper.MkExpErr(
+				`bad name for a named check: "1abc"`,
+				"it must be a valid identifier"),
+			name:      "1abc",
+			checkExpr: "OK",
+		},
+		{
+			ID: testhelper.MkID("bad: recursive"),
+			ExpErr: testhelper.MkExpErr(
+				`the named check "loop" is defined recursively: loop -> loop`),
+			name:      "loop",
+			checkExpr: "And(GE(1), loop)",
+		},
+		{
+			ID: testhelper.MkID("bad: bad check"),
+			ExpErr: testhelper.MkExpErr(
+				`bad named check "bad"`,
+				"nonesuch is an unknown function"),
+			name:      "bad",
+			checkExpr: "And(GE(1), nonesuch)",
+		},
+		{
+			ID: testhelper.MkID("bad: bad syntax"),
+			ExpErr: testhelper.MkExpErr(
+				`bad named check "bad"`),
+			name:      "bad",
+			checkExpr: "And(GE(1)",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := p.AddNamedCheck(tc.name, tc.checkExpr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+
+	testhelper.DiffInt(t, "named checks", "count", len(p.NamedChecks()), 2)
+
+	makerFuncs := p.MakerFuncs()
+	testhelper.DiffInt(t, "maker funcs", "count", len(makerFuncs), len(makers))
+
+	av := checksetter.AllowedValues(checkerName, makerFuncs)
+	gfcAval.Check(t, "Allowed Values", "named-checks", []byte(av))
+}
+
+func TestParseNamedChecks(t *testing.T) {
+	const checkerName = "TestParseNamedChecks"
+
+	p, _ := mkNamedCheckTestParser(t, checkerName)
+
+	err := p.AddNamedCheck("small", "And(GE(0), LE(9))")
+	if err != nil {
+		t.Fatal("couldn't add the named check: " + err.Error())
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr        string
+		expLen      int
+		passingVals []int
+		failingVals []int
+	}{
+		{
+			ID:          testhelper.MkID("program named check"),
+			expr:        "small",
+			expLen:      1,
+			passingVals: []int{0, 9},
+			failingVals: []int{-1, 10},
+		},
+		{
+			ID:          testhelper.MkID("program named check, nested"),
+			expr:        `Not(small, "a small number")`,
+			expLen:      1,
+			passingVals: []int{-1, 10},
+			failingVals: []int{0, 9},
+		},
+		{
+			ID:          testhelper.MkID("local named check"),
+			expr:        `validPort: And(GE(1024), LE(65535)), validPort`,
+			expLen:      1,
+			passingVals: []int{1024, 65535},
+			failingVals: []int{1023, 65536},
+		},
+		{
+			ID: testhelper.MkID("local named check, used before defined"),
+			expr: `Not(validPort, "a user port"),` +
+				` validPort: And(GE(1024), LE(65535))`,
+			expLen:      1,
+			passingVals: []int{1023, 65536},
+			failingVals: []int{1024, 65535},
+		},
+		{
+			ID: testhelper.MkID("local named checks, chained"),
+			expr: `big: GE(100), notHuge: Not(GE(1000), "huge"),` +
+				` medium: And(big, notHuge), medium, OK`,
+			expLen:      2,
+			passingVals: []int{100, 999},
+			failingVals: []int{99, 1000},
+		},
+		{
+			ID: testhelper.MkID("bad: called named check"),
+			ExpErr: testhelper.MkExpErr(
+				"small is a named check, it cannot be called"),
+			expr: "small()",
+		},
+		{
+			ID: testhelper.MkID("bad: local named check, recursive"),
+			ExpErr: testhelper.MkExpErr(
+				`the named check "a" is defined recursively: a -> b -> a`),
+			expr: `a: And(b, OK), b: Not(a, "a"), a`,
+		},
+		{
+			ID: testhelper.MkID("bad: local named check, duplicate"),
+			ExpErr: testhelper.MkExpErr(
+				`bad name for a named check: "a"`,
+				"it is already a named check"),
+			expr: `a: OK, a: GE(1), a`,
+		},
+		{
+			ID: testhelper.MkID("bad: local named check, hides program one"),
+			ExpErr: testhelper.MkExpErr(
+				`bad name for a named check: "small"`,
+				"it is already a named check"),
+			expr: `small: OK, small`,
+		},
+		{
+			ID: testhelper.MkID("bad: local named check, bad name"),
+			ExpErr: testhelper.MkExpErr(
+				"bad named check definition:",
+				"the name must be an identifier, not a *ast.BasicLit"),
+			expr: `"a": OK`,
+		},
+		{
+			ID: testhelper.MkID("bad: local named check, bad check"),
+			ExpErr: testhelper.MkExpErr(
+				`bad named check "a"`,
+				"nonesuch is an unknown function"),
+			expr: `a: nonesuch, OK`,
+		},
+	}
+
+	for _, tc := range testCases {
+		vcs, err := p.Parse(tc.expr)
+		if testhelper.CheckExpErr(t, err, tc) &&
+			err == nil &&
+			testhelper.DiffInt(t, tc.IDStr(), "number of ValCk funcs",
+				len(vcs), tc.expLen) {
+			for _, v := range tc.passingVals {
+				if err := vcs[0](v); err != nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: unexpected error checking %d: %s", v, err)
+					t.Error("\t: Bad check")
+				}
+			}
+
+			for _, v := range tc.failingVals {
+				if err := vcs[0](v); err == nil {
+					t.Log(tc.IDStr())
+					t.Logf("\t: missing error checking %d", v)
+					t.Error("\t: Bad check")
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,451 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"strings"
+)
+
+// comparisonMakers maps the comparison operators to the names of the
+// functions which they are translated into. Note that '!=' is translated
+// into a Not of the '==' function.
+var comparisonMakers = map[token.Token]string{
+	token.EQL: "EQ",
+	token.NEQ: "EQ",
+	token.GTR: "GT",
+	token.GEQ: "GE",
+	token.LSS: "LT",
+	token.LEQ: "LE",
+}
+
+// operatorsAllowedValues describes the use of operators in a list of checks
+const operatorsAllowedValues = "The checks can also be written using" +
+	" operators: the comparison operators (==, !=, <, <=, > and >=)" +
+	" are written before the value to compare against and the checks" +
+	" can be combined with &&, || and ! as in Go," +
+	" for instance: '>= 0 && < 100 || == -1'." +
+	" The operators cannot be used in the arguments of a function," +
+	" so write 'And(GT(1), LT(5))' rather than 'And(> 1, < 5)'."
+
+// WithOperators returns a copy of the Parser which will also accept checks
+// written using Go-like operators. The comparison operators (==, !=, <, <=,
+// >, >=) are written before the value to compare against and are
+// translated into the EQ, GT, GE, LT and LE functions; the logical
+// operators (&&, || and !) are translated into the And, Or and Not
+// functions. The precedence of the operators is as in Go and parentheses
+// can be used for grouping. The value after a comparison operator is a
+// literal value or the name of a constant, either of which may be preceded
+// by a '-'. Checks written as functions can be combined with the operators,
+// so for instance:
+//
+//	>= 0 && < 100 || == -1, !HasPrefix("tmp")
+//
+// but the operators can only be used outside the functions; the arguments
+// of a function are given as for a Parser without operators, so a check
+// such as 'And(> 1, < 5)' must be written as 'And(GT(1), LT(5))'.
+//
+// The named checks and constants of the Parser are shared with the copy.
+func (p Parser[T]) WithOperators() *Parser[T] {
+	p.operators = true
+	return &p
+}
+
+// AllowsOperators returns true if the Parser accepts checks written using
+// operators.
+func (p Parser[T]) AllowsOperators() bool {
+	return p.operators
+}
+
+// opToken records the details of a token from the string being parsed
+type opToken struct {
+	pos token.Pos
+	tok token.Token
+	lit string
+}
+
+// String returns a description of the token suitable for error messages
+func (t opToken) String() string {
+	switch {
+	case t.tok == token.EOF:
+		return "the end of the checks"
+	case t.lit != "":
+		return fmt.Sprintf("%q", t.lit)
+	}
+
+	return fmt.Sprintf("'%s'", t.tok)
+}
+
+// opParser holds the state of the translation of a string with operators
+// into the equivalent check expressions.
+type opParser[T any] struct {
+	p      Parser[T]
+	src    string
+	fset   *token.FileSet
+	file   *token.File
+	toks   []opToken
+	tokIdx int
+}
+
+// opParseError returns an error reporting the problem at the given position
+func (op *opParser[T]) opParseError(pos token.Pos, format string, a ...any) error {
+	return fmt.Errorf("%s: %s",
+		op.fset.Position(pos), fmt.Sprintf(format, a...))
+}
+
+// getEltsWithOperators converts the string into a slice of expressions,
+// translating any operators into the equivalent functions. Each comparison
+// is checked as it is translated so that any errors can be reported at the
+// position of the operator. The positions are reported relative to the
+// start of the string, using the file name if it is not empty. The FileSet
+// holding the positions of the expressions is also returned.
+func (p Parser[T]) getEltsWithOperators(s, fileName string) (
+	[]ast.Expr, *token.FileSet, error,
+) {
+	op := &opParser[T]{
+		p:    p,
+		src:  s,
+		fset: token.NewFileSet(),
+	}
+	op.file = op.fset.AddFile(fileName, op.fset.Base(), len(s))
+
+	var (
+		sc       scanner.Scanner
+		scanErrs scanner.ErrorList
+	)
+
+	sc.Init(op.file, []byte(s), scanErrs.Add, 0)
+
+	for {
+		pos, tok, lit := sc.Scan()
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+
+		op.toks = append(op.toks, opToken{pos: pos, tok: tok, lit: lit})
+
+		if tok == token.EOF {
+			break
+		}
+	}
+
+	if err := scanErrs.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	elts, err := op.parseList()
+
+	return elts, op.fset, err
+}
+
+// peek returns the current token
+func (op *opParser[T]) peek() opToken {
+	return op.toks[op.tokIdx]
+}
+
+// next returns the current token and moves on to the next one
+func (op *opParser[T]) next() opToken {
+	t := op.toks[op.tokIdx]
+	if t.tok != token.EOF {
+		op.tokIdx++
+	}
+
+	return t
+}
+
+// parseList parses the comma-separated list of checks
+func (op *opParser[T]) parseList() ([]ast.Expr, error) {
+	elts := []ast.Expr{}
+
+	for op.peek().tok != token.EOF {
+		elt, err := op.parseElt()
+		if err != nil {
+			return nil, err
+		}
+
+		elts = append(elts, elt)
+
+		switch t := op.next(); t.tok {
+		case token.COMMA, token.EOF:
+		default:
+			return nil, op.opParseError(t.pos, "expected ',', found %s", t)
+		}
+	}
+
+	return elts, nil
+}
+
+// parseElt parses a single element of the list, which may be a named
+// check definition
+func (op *opParser[T]) parseElt() (ast.Expr, error) {
+	if t := op.peek(); t.tok == token.IDENT &&
+		op.toks[op.tokIdx+1].tok == token.COLON {
+		op.next()
+		colon := op.next()
+
+		val, err := op.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		return &ast.KeyValueExpr{
+			Key:   &ast.Ident{NamePos: t.pos, Name: t.lit},
+			Colon: colon.pos,
+			Value: val,
+		}, nil
+	}
+
+	return op.parseOr()
+}
+
+// parseOr parses a sequence of checks separated by '||'
+func (op *opParser[T]) parseOr() (ast.Expr, error) {
+	return op.parseLogical(token.LOR, "Or", op.parseAnd)
+}
+
+// parseAnd parses a sequence of checks separated by '&&'
+func (op *opParser[T]) parseAnd() (ast.Expr, error) {
+	return op.parseLogical(token.LAND, "And", op.parseUnary)
+}
+
+// parseLogical parses a sequence of operands separated by the given
+// logical operator. If there is more than one operand they are combined
+// into a call of the named function.
+func (op *opParser[T]) parseLogical(
+	opTok token.Token, fName string, parseOperand func() (ast.Expr, error),
+) (ast.Expr, error) {
+	first, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	if op.peek().tok != opTok {
+		return first, nil
+	}
+
+	opPos := op.peek().pos
+	if err := op.checkMaker(opPos, opTok, fName); err != nil {
+		return nil, err
+	}
+
+	args := []ast.Expr{first}
+
+	for op.peek().tok == opTok {
+		op.next()
+
+		operand, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, operand)
+	}
+
+	return &ast.CallExpr{
+		Fun:    &ast.Ident{NamePos: opPos, Name: fName},
+		Lparen: opPos,
+		Args:   args,
+		Rparen: opPos,
+	}, nil
+}
+
+// checkMaker returns an error if the Parser has no maker for the function
+// that the operator is translated into
+func (op *opParser[T]) checkMaker(pos token.Pos, opTok token.Token, fName string) error {
+	if _, ok := op.p.makers[fName]; !ok {
+		return op.opParseError(pos,
+			"the '%s' operator is not supported: %s has no %s function",
+			opTok, op.p.checkerName, fName)
+	}
+
+	return nil
+}
+
+// parseUnary parses a check which may be preceded by a '!', a comparison
+// operator or a parenthesised expression
+func (op *opParser[T]) parseUnary() (ast.Expr, error) {
+	t := op.peek()
+
+	switch t.tok {
+	case token.NOT:
+		op.next()
+
+		if err := op.checkMaker(t.pos, t.tok, "Not"); err != nil {
+			return nil, err
+		}
+
+		start := op.peek().pos
+
+		operand, err := op.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		desc := strings.TrimSpace(
+			op.src[op.file.Offset(start):op.file.Offset(op.peek().pos)])
+
+		return mkNotCall(t.pos, operand, "accepted by: "+desc), nil
+	case token.LPAREN:
+		op.next()
+
+		expr, err := op.parseOr()
+		if err != nil {
+			return nil, err
+		}
+
+		if closing := op.next(); closing.tok != token.RPAREN {
+			return nil,
+				op.opParseError(closing.pos, "expected ')', found %s", closing)
+		}
+
+		return expr, nil
+	case token.IDENT:
+		return op.parseFuncCheck()
+	}
+
+	if _, ok := comparisonMakers[t.tok]; ok {
+		return op.parseComparison()
+	}
+
+	return nil, op.opParseError(t.pos, "expected a check, found %s", t)
+}
+
+// mkNotCall returns a CallExpr for the Not function applied to the operand
+// with the given error message
+func mkNotCall(pos token.Pos, operand ast.Expr, msg string) *ast.CallExpr {
+	return &ast.CallExpr{
+		Fun:    &ast.Ident{NamePos: pos, Name: "Not"},
+		Lparen: pos,
+		Args: []ast.Expr{
+			operand,
+			&ast.BasicLit{ValuePos: pos, Kind: token.STRING, Value: `"` + msg + `"`},
+		},
+		Rparen: pos,
+	}
+}
+
+// parseComparison parses a comparison operator and the value that follows
+// it. The resulting check is made straight away so that any error can be
+// reported at the position of the operator.
+func (op *opParser[T]) parseComparison() (ast.Expr, error) {
+	opTok := op.next()
+	fName := comparisonMakers[opTok.tok]
+
+	if err := op.checkMaker(opTok.pos, opTok.tok, fName); err != nil {
+		return nil, err
+	}
+
+	val, err := op.parseValue()
+	if err != nil {
+		return nil, err
+	}
+
+	var expr ast.Expr = &ast.CallExpr{
+		Fun:    &ast.Ident{NamePos: opTok.pos, Name: fName},
+		Lparen: opTok.pos,
+		Args:   []ast.Expr{val},
+		Rparen: val.End(),
+	}
+
+	if opTok.tok == token.NEQ {
+		if err := op.checkMaker(opTok.pos, opTok.tok, "Not"); err != nil {
+			return nil, err
+		}
+
+		valDesc := op.src[op.file.Offset(val.Pos()):op.file.Offset(val.End())]
+		expr = mkNotCall(opTok.pos, expr, "equal to "+strings.Trim(valDesc, "\"`"))
+	}
+
+	if _, err := op.p.ParseExpr(expr); err != nil {
+		return nil, op.opParseError(opTok.pos, "%s: %s", opTok, err)
+	}
+
+	return expr, nil
+}
+
+// parseValue parses the value following a comparison operator. This can be
+// a literal value or the name of a constant, optionally preceded by a '-'.
+func (op *opParser[T]) parseValue() (ast.Expr, error) {
+	t := op.next()
+
+	switch t.tok {
+	case token.INT, token.FLOAT, token.STRING, token.CHAR:
+		return &ast.BasicLit{ValuePos: t.pos, Kind: t.tok, Value: t.lit}, nil
+	case token.IDENT:
+		return &ast.Ident{NamePos: t.pos, Name: t.lit}, nil
+	case token.SUB:
+		val := op.next()
+
+		switch val.tok {
+		case token.INT, token.FLOAT:
+			return &ast.BasicLit{
+				ValuePos: t.pos, Kind: val.tok, Value: "-" + val.lit,
+			}, nil
+		case token.IDENT:
+			// as in a function argument, such as the Inf in GT(-Inf)
+			return &ast.UnaryExpr{
+				OpPos: t.pos,
+				Op:    token.SUB,
+				X:     &ast.Ident{NamePos: val.pos, Name: val.lit},
+			}, nil
+		}
+
+		return nil,
+			op.opParseError(val.pos, "expected a number or a name, found %s",
+				val)
+	}
+
+	return nil, op.opParseError(t.pos, "expected a value, found %s", t)
+}
+
+// parseFuncCheck parses a check given as a function call or the name of a
+// function or named check
+func (op *opParser[T]) parseFuncCheck() (ast.Expr, error) {
+	id := op.next()
+
+	if op.peek().tok != token.LPAREN {
+		return &ast.Ident{NamePos: id.pos, Name: id.lit}, nil
+	}
+
+	depth := 0
+
+	for {
+		t := op.next()
+
+		switch t.tok {
+		case token.LPAREN:
+			depth++
+		case token.RPAREN:
+			depth--
+		case token.EOF:
+			return nil, op.opParseError(t.pos, "expected ')', found %s", t)
+		}
+
+		if depth == 0 {
+			break
+		}
+	}
+
+	// The function call is parsed in place, with the preceding text blanked
+	// out, so that the positions in the expression (and in any error
+	// message) are the same as in the original string
+	start := op.file.Offset(id.pos)
+	end := op.file.Offset(op.toks[op.tokIdx-1].pos) + 1
+	callSrc := blankOut(op.src[:start]) + op.src[start:end]
+
+	return parser.ParseExprFrom(token.NewFileSet(), op.file.Name(), callSrc, 0)
+}
+
+// blankOut returns a copy of the string with every character other than
+// newlines replaced by a space
+func blankOut(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' {
+			return r
+		}
+
+		return ' '
+	}, s)
+}
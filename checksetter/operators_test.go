@@ -0,0 +1,210 @@
+package checksetter_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestParseWithOperators(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName).
+		WithOperators()
+
+	if !p.AllowsOperators() {
+		t.Fatal("the Parser should allow operators")
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr        string
+		expLen      int
+		passingVals []int
+		failingVals []int
+	}{
+		{
+			ID:          testhelper.MkID("single comparison"),
+			expr:        "> 1",
+			expLen:      1,
+			passingVals: []int{2, 100},
+			failingVals: []int{0, 1},
+		},
+		{
+			ID:          testhelper.MkID("and, not equal"),
+			expr:        "> 1 && != 5",
+			expLen:      1,
+			passingVals: []int{2, 4, 6},
+			failingVals: []int{1, 5},
+		},
+		{
+			ID:          testhelper.MkID("precedence, negative value"),
+			expr:        ">= 0 && < 100 || == -1",
+			expLen:      1,
+			passingVals: []int{-1, 0, 99},
+			failingVals: []int{-2, 100},
+		},
+		{
+			ID:          testhelper.MkID("parentheses"),
+			expr:        ">= 0 && (< 10 || > 90)",
+			expLen:      1,
+			passingVals: []int{0, 9, 91},
+			failingVals: []int{-1, 10, 90},
+		},
+		{
+			ID:          testhelper.MkID("not"),
+			expr:        "!(>= 10 && <= 20)",
+			expLen:      1,
+			passingVals: []int{9, 21},
+			failingVals: []int{10, 20},
+		},
+		{
+			ID:          testhelper.MkID("mixed with functions"),
+			expr:        "Between(0, 10) && != 5, <= 8",
+			expLen:      2,
+			passingVals: []int{0, 4, 6},
+			failingVals: []int{5, 10},
+		},
+		{
+			ID:          testhelper.MkID("named check"),
+			expr:        "small: >= 0 && < 10, small || == 99",
+			expLen:      1,
+			passingVals: []int{0, 9, 99},
+			failingVals: []int{-1, 10},
+		},
+		{
+			ID:     testhelper.MkID("multi-line"),
+			expr:   ">= 0 &&\n< 10",
+			expLen: 1,
+		},
+		{
+			ID: testhelper.MkID("bad: missing operand"),
+			ExpErr: testhelper.MkExpErr(
+				"1:7: expected a check, found the end of the checks"),
+			expr: "> 1 &&",
+		},
+		{
+			ID: testhelper.MkID("bad: missing value"),
+			ExpErr: testhelper.MkExpErr(
+				"1:10: expected a value, found '&&'"),
+			expr: "> 1 && < && > 2",
+		},
+		{
+			ID: testhelper.MkID("bad: missing close paren"),
+			ExpErr: testhelper.MkExpErr(
+				"2:4: expected ')', found the end of the checks"),
+			expr: "(> 1 &&\n< 2",
+		},
+		{
+			ID: testhelper.MkID("bad: wrong value type"),
+			ExpErr: testhelper.MkExpErr(
+				"2:1: '>': GT(int):",
+				`"1.5" isn't an INT, it's a FLOAT`),
+			expr: ">= 0 &&\n> 1.5",
+		},
+		{
+			ID: testhelper.MkID("bad: missing comma"),
+			ExpErr: testhelper.MkExpErr(
+				"1:5: expected ',', found '>'"),
+			expr: "> 1 > 2",
+		},
+	}
+
+	for _, tc := range testCases {
+		vcs, err := p.Parse(tc.expr)
+		if testhelper.CheckExpErr(t, err, tc) &&
+			err == nil &&
+			testhelper.DiffInt(t, tc.IDStr(), "number of ValCk funcs",
+				len(vcs), tc.expLen) {
+			for _, v := range tc.passingVals {
+				for _, vc := range vcs {
+					if err := vc(v); err != nil {
+						t.Log(tc.IDStr())
+						t.Logf("\t: unexpected error checking %d: %s", v, err)
+						t.Error("\t: Bad check")
+					}
+				}
+			}
+
+			for _, v := range tc.failingVals {
+				failed := false
+
+				for _, vc := range vcs {
+					if vc(v) != nil {
+						failed = true
+					}
+				}
+
+				if !failed {
+					t.Log(tc.IDStr())
+					t.Logf("\t: missing error checking %d", v)
+					t.Error("\t: Bad check")
+				}
+			}
+		}
+	}
+
+	_, err := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName).
+		Parse("> 1")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("operators not allowed"),
+		ExpErr: testhelper.MkExpErr("expected operand"),
+	})
+}
+
+func TestParseWithOperatorsOtherFamilies(t *testing.T) {
+	sp := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName).
+		WithOperators()
+
+	vcs, err := sp.Parse(`!HasPrefix("tmp") && != "x"`)
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	for v, expOK := range map[string]bool{
+		"abc":    true,
+		"tmpabc": false,
+		"x":      false,
+	} {
+		err := vcs[0](v)
+		if (err == nil) != expOK {
+			t.Errorf("checking %q: expected ok: %t, got error: %v",
+				v, expOK, err)
+		}
+	}
+
+	fp := checksetter.FindParserOrPanic[float64](
+		checksetter.Float64CheckerName).WithOperators()
+
+	fvcs, err := fp.Parse("> -Inf && < Inf")
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	for v, expOK := range map[float64]bool{
+		-1e300:       true,
+		math.Inf(-1): false,
+		math.Inf(1):  false,
+	} {
+		err := fvcs[0](v)
+		if (err == nil) != expOK {
+			t.Errorf("checking %g: expected ok: %t, got error: %v",
+				v, expOK, err)
+		}
+	}
+
+	_, err = fp.Parse("== 1.5")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("unsupported operator"),
+		ExpErr: testhelper.MkExpErr("1:1:",
+			"the '==' operator is not supported:",
+			"float64-checker has no EQ function"),
+	})
+}
@@ -0,0 +1,390 @@
+package checksetter
+
+import (
+	"go/ast"
+	"go/token"
+	"strconv"
+	"time"
+)
+
+// WithOptimiser returns a copy of the Parser which will simplify each check
+// before making the check func. Nested And and Or checks are flattened,
+// OK is removed from And (and an Or containing OK becomes OK), the
+// comparisons in an And (EQ, GT, GE, LT, LE and Between) are merged into
+// a single check of the tightest bounds and the EQ and OneOf checks in an
+// Or are merged into a single OneOf which is checked with a set lookup.
+// Open bounds of whole numbers are closed before they are merged, so that
+// GT(1) is treated as GE(2). For instance:
+//
+//	And(And(GT(1), OK), Or(LT(100)), LE(50))
+//
+// is made as if it had been written 'Between(2, 50)'. The checks are
+// still parsed as written so any errors are reported as for an
+// unoptimised Parser, but note that the error messages reported by the
+// optimised checks may differ.
+//
+// The named checks, constants and units of the Parser are shared with the
+// copy.
+func (p Parser[T]) WithOptimiser() *Parser[T] {
+	p.optimise = true
+	return &p
+}
+
+// Optimises returns true if the Parser simplifies the checks before making
+// the check funcs.
+func (p Parser[T]) Optimises() bool {
+	return p.optimise
+}
+
+// optBound records a comparison which bounds the values at one end
+type optBound struct {
+	val  ordVal
+	open bool
+	arg  ast.Expr
+}
+
+// optimiser holds the state needed to simplify a check
+type optimiser[T any] struct {
+	a *analyser[T]
+}
+
+// mkCall returns a call of the named function with the given arguments
+func mkCall(pos token.Pos, name string, args ...ast.Expr) *ast.CallExpr {
+	return &ast.CallExpr{
+		Fun:    &ast.Ident{NamePos: pos, Name: name},
+		Lparen: pos,
+		Args:   args,
+	}
+}
+
+// hasMaker returns true if the Parser has a maker of the given name taking
+// the given arguments
+func (o optimiser[T]) hasMaker(name string, args ...string) bool {
+	mi, ok := o.a.p.makers[name]
+	return ok && hasArgs(mi.Args, args...)
+}
+
+// call returns the name of the function called by the expression and the
+// call with any constants and units substituted. A function given by name
+// alone has a nil CallExpr. It returns false if the function is not one of
+// the Parser's makers.
+func (o optimiser[T]) call(expr ast.Expr) (string, *ast.CallExpr, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if _, ok := o.a.p.makers[e.Name]; ok {
+			return e.Name, nil, true
+		}
+	case *ast.CallExpr:
+		name, err := getFuncName(e)
+		if err != nil {
+			return "", nil, false
+		}
+
+		mi, ok := o.a.p.makers[name]
+		if !ok {
+			return "", nil, false
+		}
+
+		call, err := o.a.p.substituteConstants(e, mi.Args)
+		if err == nil {
+			call, err = o.a.p.substituteUnits(call, mi.Args)
+		}
+
+		return name, call, err == nil
+	}
+
+	return "", nil, false
+}
+
+// isOK returns true if the expression is a call of OK with no arguments
+func (o optimiser[T]) isOK(expr ast.Expr) bool {
+	name, call, ok := o.call(expr)
+
+	return ok && name == "OK" && (call == nil || len(call.Args) == 0) &&
+		o.hasMaker("OK")
+}
+
+// flatten returns the arguments of the call, replacing any which are calls
+// of the same function by their arguments, recursively
+func (o optimiser[T]) flatten(name string, args []ast.Expr) []ast.Expr {
+	flat := make([]ast.Expr, 0, len(args))
+
+	for _, arg := range args {
+		arg = o.expr(arg)
+
+		if argName, call, ok := o.call(arg); ok && argName == name &&
+			call != nil {
+			flat = append(flat, o.flatten(name, call.Args)...)
+			continue
+		}
+
+		flat = append(flat, arg)
+	}
+
+	return flat
+}
+
+// closed returns the bound moved by one and closed if the values are whole
+// numbers and the bound is open, so that, for instance, GT(1) is treated
+// as GE(2). Otherwise, or if the moved bound is too big for the type, it
+// returns the bound unchanged.
+func (o optimiser[T]) closed(b *optBound, step int64) *optBound {
+	limits, ok := integralLimits[o.a.kind]
+	if !ok || !b.open {
+		return b
+	}
+
+	v := b.val.add(step)
+
+	n := v.num.Num()
+	if !n.IsInt64() || n.Int64() < limits[0] || n.Int64() > limits[1] {
+		return b
+	}
+
+	arg := ast.Expr(constantLit(n.Int64(), b.arg.Pos()))
+	if o.a.kind == DurationArg.Name {
+		arg = &ast.BasicLit{
+			ValuePos: b.arg.Pos(),
+			Kind:     token.STRING,
+			Value:    strconv.Quote(time.Duration(n.Int64()).String()),
+		}
+	}
+
+	return &optBound{val: v, arg: arg}
+}
+
+// bounds returns the bounds given by the comparison. An open bound of
+// whole numbers is closed (see closed) so that it can be merged with
+// others into a Between. It returns false if the expression is not a
+// comparison of the Parser's ordered kind.
+func (o optimiser[T]) bounds(expr ast.Expr) (lo, hi *optBound, ok bool) {
+	name, call, ok := o.call(expr)
+	if !ok || call == nil {
+		return nil, nil, false
+	}
+
+	mkBound := func(idx int, open bool, step int64) *optBound {
+		v, err := o.a.decode(call, idx)
+		if err != nil {
+			ok = false
+		}
+
+		return o.closed(&optBound{val: v, open: open, arg: call.Args[idx]},
+			step)
+	}
+
+	kind := o.a.kind
+
+	switch {
+	case name == "EQ" && o.hasMaker(name, kind):
+		lo = mkBound(0, false, 0)
+		hi = lo
+	case (name == "GT" || name == "GE") && o.hasMaker(name, kind):
+		lo = mkBound(0, name == "GT", 1)
+	case (name == "LT" || name == "LE") && o.hasMaker(name, kind):
+		hi = mkBound(0, name == "LT", -1)
+	case name == "Between" && o.hasMaker(name, kind, kind):
+		lo, hi = mkBound(0, false, 0), mkBound(1, false, 0)
+	default:
+		return nil, nil, false
+	}
+
+	return lo, hi, ok
+}
+
+// tighter returns the bound which allows fewer values. The sign is
+// positive for a lower bound and negative for an upper bound.
+func tighter(a, b *optBound, sign int) *optBound {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	}
+
+	c := a.val.cmp(b.val) * sign
+	if c > 0 || (c == 0 && a.open) {
+		return a
+	}
+
+	return b
+}
+
+// boundsCheck returns a single check of the bounds. It returns false if the
+// bounds cannot be combined into a single check because there are no
+// values between them.
+func (o optimiser[T]) boundsCheck(pos token.Pos, lo, hi *optBound) (
+	[]ast.Expr, bool,
+) {
+	switch {
+	case lo == nil:
+		return []ast.Expr{mkCall(pos, cmpName("LE", "LT", hi.open), hi.arg)},
+			true
+	case hi == nil:
+		return []ast.Expr{mkCall(pos, cmpName("GE", "GT", lo.open), lo.arg)},
+			true
+	}
+
+	c := lo.val.cmp(hi.val)
+
+	switch {
+	case c > 0, c == 0 && (lo.open || hi.open):
+		return nil, false
+	case c == 0 && o.hasMaker("EQ", o.a.kind):
+		return []ast.Expr{mkCall(pos, "EQ", lo.arg)}, true
+	case c < 0 && !lo.open && !hi.open &&
+		o.hasMaker("Between", o.a.kind, o.a.kind):
+		return []ast.Expr{mkCall(pos, "Between", lo.arg, hi.arg)}, true
+	}
+
+	return []ast.Expr{
+		mkCall(pos, cmpName("GE", "GT", lo.open), lo.arg),
+		mkCall(pos, cmpName("LE", "LT", hi.open), hi.arg),
+	}, true
+}
+
+// cmpName returns the name of the comparison to use for an open or closed
+// bound
+func cmpName(closed, open string, isOpen bool) string {
+	if isOpen {
+		return open
+	}
+
+	return closed
+}
+
+// and returns the simplified arguments of an And
+func (o optimiser[T]) and(pos token.Pos, args []ast.Expr) []ast.Expr {
+	var (
+		lo, hi    *optBound
+		bounded   []ast.Expr
+		remaining []ast.Expr
+	)
+
+	for _, arg := range o.flatten("And", args) {
+		if o.isOK(arg) {
+			continue
+		}
+
+		if argLo, argHi, ok := o.bounds(arg); ok {
+			lo, hi = tighter(lo, argLo, 1), tighter(hi, argHi, -1)
+			bounded = append(bounded, arg)
+
+			continue
+		}
+
+		remaining = append(remaining, arg)
+	}
+
+	if len(bounded) > 1 {
+		if merged, ok := o.boundsCheck(pos, lo, hi); ok {
+			bounded = merged
+		}
+	}
+
+	return append(bounded, remaining...)
+}
+
+// or returns the simplified arguments of an Or. It returns false if one of
+// the arguments is OK so the Or will always pass.
+func (o optimiser[T]) or(pos token.Pos, args []ast.Expr) ([]ast.Expr, bool) {
+	var (
+		vals      []ast.Expr
+		valChecks []ast.Expr
+		remaining []ast.Expr
+	)
+
+	canMerge := o.hasMaker("OneOf", variadicArgMarker, o.a.kind)
+
+	for _, arg := range o.flatten("Or", args) {
+		if o.isOK(arg) {
+			return nil, false
+		}
+
+		name, call, ok := o.call(arg)
+		if canMerge && ok && call != nil &&
+			(name == "EQ" && o.hasMaker(name, o.a.kind) || name == "OneOf") {
+			vals = append(vals, call.Args...)
+			valChecks = append(valChecks, arg)
+
+			continue
+		}
+
+		remaining = append(remaining, arg)
+	}
+
+	if len(valChecks) > 1 {
+		valChecks = []ast.Expr{mkCall(pos, "OneOf", vals...)}
+	}
+
+	return append(valChecks, remaining...), true
+}
+
+// expr returns the simplified check
+func (o optimiser[T]) expr(expr ast.Expr) ast.Expr {
+	name, call, ok := o.call(expr)
+	if !ok || call == nil {
+		return expr
+	}
+
+	pos := expr.Pos()
+	cn := o.a.p.checkerName
+
+	switch {
+	case name == "And" && o.hasMaker(name, variadicArgMarker, cn):
+		args := o.and(pos, call.Args)
+
+		switch len(args) {
+		case 0:
+			if o.hasMaker("OK") {
+				return &ast.Ident{NamePos: pos, Name: "OK"}
+			}
+		case 1:
+			return args[0]
+		}
+
+		return mkCall(pos, name, args...)
+	case name == "Or" && o.hasMaker(name, variadicArgMarker, cn):
+		args, ok := o.or(pos, call.Args)
+		if !ok {
+			return &ast.Ident{NamePos: pos, Name: "OK"}
+		}
+
+		if len(args) == 1 {
+			return args[0]
+		}
+
+		return mkCall(pos, name, args...)
+	}
+
+	return o.nested(call, o.a.p.makers[name].Args)
+}
+
+// nested returns the call with any checks of the Parser's family given as
+// arguments simplified
+func (o optimiser[T]) nested(call *ast.CallExpr, args []string) ast.Expr {
+	newCall := *call
+	newCall.Args = make([]ast.Expr, 0, len(call.Args))
+
+	for i, arg := range call.Args {
+		if kind, ok := argKindAt(args, i); ok && kind == o.a.p.checkerName {
+			arg = o.expr(arg)
+		}
+
+		newCall.Args = append(newCall.Args, arg)
+	}
+
+	return &newCall
+}
+
+// optimiseExpr returns the simplified check. If the Parser has no
+// comparison checks the bounds are not merged but the other
+// simplifications are made.
+func (p Parser[T]) optimiseExpr(expr ast.Expr) ast.Expr {
+	kind, integral, _ := p.orderedKind()
+	o := optimiser[T]{
+		a: &analyser[T]{p: p, kind: kind, integral: integral},
+	}
+
+	return o.expr(expr)
+}
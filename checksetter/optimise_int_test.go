@@ -0,0 +1,127 @@
+package checksetter
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/types"
+	"testing"
+	"time"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestOptimiseExpr(t *testing.T) {
+	pInt := getParserRegisterEntry[int](t, IntCheckerName)
+	pFloat := getParserRegisterEntry[float64](t, Float64CheckerName)
+	pString := getParserRegisterEntry[string](t, StringCheckerName)
+	pDur := getParserRegisterEntry[time.Duration](t, DurationCheckerName)
+
+	type optimiseFunc func(s string) string
+
+	mkOptFunc := func(optimise func(e ast.Expr) ast.Expr) optimiseFunc {
+		return func(s string) string {
+			e, err := parser.ParseExpr(s)
+			if err != nil {
+				t.Fatalf("couldn't parse %q: %s", s, err)
+			}
+
+			return types.ExprString(optimise(e))
+		}
+	}
+
+	optInt := mkOptFunc(pInt.optimiseExpr)
+	optFloat := mkOptFunc(pFloat.optimiseExpr)
+	optString := mkOptFunc(pString.optimiseExpr)
+	optDur := mkOptFunc(pDur.optimiseExpr)
+
+	testCases := []struct {
+		testhelper.ID
+		optimise optimiseFunc
+		expr     string
+		expExpr  string
+	}{
+		{
+			ID:       testhelper.MkID("flatten and drop OK"),
+			optimise: optInt,
+			expr:     "And(And(GT(1), OK), Or(LT(100)))",
+			expExpr:  "Between(2, 99)",
+		},
+		{
+			ID:       testhelper.MkID("merge closed bounds"),
+			optimise: optInt,
+			expr:     "And(GE(1), Divides(60), LE(100), GE(5), Between(0, 50))",
+			expExpr:  "And(Between(5, 50), Divides(60))",
+		},
+		{
+			ID:       testhelper.MkID("merge open int bounds"),
+			optimise: optInt,
+			expr:     "And(GT(0), LT(100))",
+			expExpr:  "Between(1, 99)",
+		},
+		{
+			ID:       testhelper.MkID("merge open duration bounds"),
+			optimise: optDur,
+			expr:     `And(GT("1s"), LE("1m"))`,
+			expExpr:  `Between("1.000000001s", "1m")`,
+		},
+		{
+			ID:       testhelper.MkID("merge to a point"),
+			optimise: optInt,
+			expr:     "And(GE(5), LE(9), EQ(7))",
+			expExpr:  "EQ(7)",
+		},
+		{
+			ID:       testhelper.MkID("unsatisfiable bounds are not merged"),
+			optimise: optInt,
+			expr:     "And(GT(10), LT(5))",
+			expExpr:  "And(GT(10), LT(5))",
+		},
+		{
+			ID:       testhelper.MkID("open bounds"),
+			optimise: optFloat,
+			expr:     "And(GT(1), GE(1), LT(Inf), LT(2.5))",
+			expExpr:  "And(GT(1), LT(2.5))",
+		},
+		{
+			ID:       testhelper.MkID("only OK"),
+			optimise: optInt,
+			expr:     "And(OK, And(OK))",
+			expExpr:  "OK",
+		},
+		{
+			ID:       testhelper.MkID("Or with OK"),
+			optimise: optInt,
+			expr:     "Or(LT(0), OK)",
+			expExpr:  "OK",
+		},
+		{
+			ID:       testhelper.MkID("Or of EQ"),
+			optimise: optInt,
+			expr:     "Or(EQ(1), Or(LT(0), EQ(3)), OneOf(5, 7))",
+			expExpr:  "Or(OneOf(1, 3, 5, 7), LT(0))",
+		},
+		{
+			ID:       testhelper.MkID("Or of a single EQ"),
+			optimise: optInt,
+			expr:     "Or(EQ(1), LT(0))",
+			expExpr:  "Or(EQ(1), LT(0))",
+		},
+		{
+			ID:       testhelper.MkID("Or of string EQ"),
+			optimise: optString,
+			expr:     `Or(EQ("a"), EQ("b"), HasPrefix("x"))`,
+			expExpr:  `Or(OneOf("a", "b"), HasPrefix("x"))`,
+		},
+		{
+			ID:       testhelper.MkID("nested in Not"),
+			optimise: optInt,
+			expr:     `Not(And(GT(1), OK), "x")`,
+			expExpr:  `Not(GT(1), "x")`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testhelper.DiffString(t, tc.IDStr(), "optimised expression",
+			tc.optimise(tc.expr), tc.expExpr)
+	}
+}
@@ -0,0 +1,100 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// optBenchChecks is a list of checks with nested And and Or checks, an OK
+// check, several bounds and an Or of EQ checks which the optimiser can
+// simplify
+const optBenchChecks = "And(And(GT(1), OK), Or(LT(10000)), GE(0), LE(5000))," +
+	" Or(EQ(3), EQ(5), EQ(7), Or(EQ(11), EQ(13), EQ(17)), EQ(19), GT(1000))"
+
+func TestOptimiser(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+	pOpt := p.WithOptimiser()
+
+	testhelper.DiffBool(t, "Parser", "Optimises", p.Optimises(), false)
+	testhelper.DiffBool(t, "WithOptimiser", "Optimises", pOpt.Optimises(),
+		true)
+
+	exprs := []string{
+		optBenchChecks,
+		"And(OK, And(OK))",
+		"Or(LT(0), OK), Not(And(GT(5), LT(10)), \"not 6-9\")",
+		"And(GT(10), LT(5))",
+		"IfElse(Or(EQ(1), EQ(2)), OK, And(GE(3), GE(4), LE(8)))",
+	}
+
+	for _, expr := range exprs {
+		vcs, err := p.Parse(expr)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q: %s", expr, err)
+		}
+
+		optVcs, err := pOpt.Parse(expr)
+		if err != nil {
+			t.Fatalf("unexpected error parsing %q (optimised): %s", expr, err)
+		}
+
+		testhelper.DiffInt(t, expr, "number of checks",
+			len(optVcs), len(vcs))
+
+		for v := -5; v <= 10005; v++ {
+			err := check.And(vcs...)(v)
+			optErr := check.And(optVcs...)(v)
+
+			if (err == nil) != (optErr == nil) {
+				t.Log(expr)
+				t.Logf("\t: checking %d: unoptimised: %v, optimised: %v",
+					v, err, optErr)
+				t.Error("\t: the optimised checks give a different result")
+
+				break
+			}
+		}
+	}
+
+	_, err := pOpt.Parse("And(GT(1), Nonesuch)")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("errors are reported as written"),
+		ExpErr: testhelper.MkExpErr(
+			"can't make int-checker function:", "Nonesuch"),
+	})
+}
+
+// benchmarkChecks runs the checks against a range of values
+func benchmarkChecks(b *testing.B, p *checksetter.Parser[int]) {
+	b.Helper()
+
+	vcs, err := p.Parse(optBenchChecks)
+	if err != nil {
+		b.Fatal("unexpected error: " + err.Error())
+	}
+
+	cf := check.And(vcs...)
+
+	b.ResetTimer()
+
+	for i := range b.N {
+		_ = cf(i % 2000) //nolint:errcheck
+	}
+}
+
+func BenchmarkUnoptimised(b *testing.B) {
+	benchmarkChecks(b,
+		checksetter.FindParserOrPanic[int](checksetter.IntCheckerName))
+}
+
+func BenchmarkOptimised(b *testing.B) {
+	benchmarkChecks(b,
+		checksetter.FindParserOrPanic[int](checksetter.IntCheckerName).
+			WithOptimiser())
+}
@@ -3,6 +3,7 @@ package checksetter
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
 	"maps"
 	"slices"
 
@@ -29,6 +30,11 @@ type MakerInfo[T any] struct {
 type Parser[T any] struct {
 	checkerName string
 	makers      map[string]MakerInfo[T]
+	namedChecks map[string]namedCheck
+	constants   map[string]any
+	units       map[string]float64
+	operators   bool
+	optimise    bool
 }
 
 // MakeParser creates a new parser and adds it to the Parser register. It
@@ -44,6 +50,9 @@ func MakeParser[T any](checkerName string, makers map[string]MakerInfo[T]) (
 	p := Parser[T]{
 		checkerName: checkerName,
 		makers:      makers,
+		namedChecks: map[string]namedCheck{},
+		constants:   map[string]any{},
+		units:       defaultUnits(),
 	}
 
 	parserRegister[checkerName] = &p
@@ -94,20 +103,72 @@ func (p Parser[T]) CheckerName() string {
 // functions of the appropriate type and an error. The error will be nil if
 // the parsing was successful, otherwise an error describing the problem and
 // a nil slice will be returned.
+//
+// An entry in the list can be given as 'name: check' in which case no
+// check.ValCk function is generated for it but the name can be used in
+// place of the check in the other entries in the list.
 func (p Parser[T]) Parse(s string) ([]check.ValCk[T], error) {
-	exprs, err := getElts(s, p.checkerName)
+	elts, err := p.listElts(s)
 	if err != nil {
 		return nil, err
 	}
 
+	return p.makeCheckFuncs(elts, nil)
+}
+
+// listElts converts the list of checks into a slice of expressions, allowing
+// operators if the Parser permits them
+func (p Parser[T]) listElts(s string) ([]ast.Expr, error) {
+	if p.operators {
+		elts, _, err := p.getEltsWithOperators(s, "")
+		return elts, err
+	}
+
+	return getElts(s, p.checkerName)
+}
+
+// makeCheckFuncs makes the check.ValCk functions from the elements of the
+// list of checks. If the FileSet is not nil then any error is reported at
+// the position of the element which caused it.
+func (p Parser[T]) makeCheckFuncs(elts []ast.Expr, fset *token.FileSet) (
+	[]check.ValCk[T], error,
+) {
+	mkErr := func(n ast.Node, err error) error {
+		err = fmt.Errorf("can't make %s function: %s", p.checkerName, err)
+		if fset == nil || n == nil {
+			return err
+		}
+
+		return fmt.Errorf("%s: %w", fset.Position(n.Pos()), err)
+	}
+
+	exprs, defs, err := p.splitLocalDefs(elts)
+	if err != nil {
+		return nil, mkErr(nil, err)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(defs)) {
+		def := defs[name]
+		if _, err := p.ParseExpr(p.expandLocalDefs(def, defs)); err != nil {
+			return nil, mkErr(def,
+				fmt.Errorf("bad named check %q: %s", name, err))
+		}
+	}
+
 	ckFuncs := make([]check.ValCk[T], 0, len(exprs))
 
 	for _, e := range exprs {
-		f, err := p.ParseExpr(e)
+		expr := p.expandLocalDefs(e, defs)
+
+		f, err := p.ParseExpr(expr)
 		if err != nil {
-			return nil,
-				fmt.Errorf("can't make %s function: %s",
-					p.checkerName, err)
+			return nil, mkErr(e, err)
+		}
+
+		if p.optimise {
+			if f, err = p.ParseExpr(p.optimiseExpr(expr)); err != nil {
+				return nil, mkErr(e, err)
+			}
 		}
 
 		ckFuncs = append(ckFuncs, f)
@@ -117,16 +178,43 @@ func (p Parser[T]) Parse(s string) ([]check.ValCk[T], error) {
 }
 
 // runMaker finds the appropriate function makerName and calls it passing the
-// CallExpr and the function name.
+// CallExpr and the function name. If there is no such function but there is
+// a named check with that name then the check it is bound to is made.
 func (p Parser[T]) runMaker(e *ast.CallExpr, makerName string) (
 	check.ValCk[T], error,
 ) {
 	maker, ok := p.makers[makerName]
 	if !ok {
-		return nil, fmt.Errorf("%s is an unknown function", makerName)
+		nc, ok := p.namedChecks[makerName]
+		if !ok {
+			return nil, fmt.Errorf("%s is an unknown function", makerName)
+		}
+
+		if e != nil {
+			return nil,
+				fmt.Errorf("%s is a named check, it cannot be called",
+					makerName)
+		}
+
+		return p.ParseExpr(nc.expr)
+	}
+
+	e, err := p.substituteConstants(e, maker.Args)
+	if err == nil {
+		e, err = p.substituteUnits(e, maker.Args)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w",
+			getCheckFuncDesc(makerName, maker.Args), err)
+	}
+
+	cf, err := maker.MF(e, makerName)
+	if err != nil {
+		return nil, p.addConstantsHint(err)
 	}
 
-	return maker.MF(e, makerName)
+	return cf, nil
 }
 
 // CallExprMaker finds the function name using the information given in the
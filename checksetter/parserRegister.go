@@ -6,103 +6,6 @@ import (
 	"slices"
 )
 
-func init() {
-	_, err := MakeParser(
-		Float64CheckerName,
-		map[string]MakerInfo[float64]{
-			"OK":      f64Maker,
-			"GT":      f64MakerF64,
-			"GE":      f64MakerF64,
-			"LT":      f64MakerF64,
-			"LE":      f64MakerF64,
-			"Between": f64MakerF64F64,
-			"Not":     f64MakerF64checkerString,
-			"And":     f64MakerMultiF64checker,
-			"Or":      f64MakerMultiF64checker,
-		})
-	if err != nil {
-		panic(err)
-	}
-
-	_, err = MakeParser(
-		IntCheckerName,
-		map[string]MakerInfo[int]{
-			"OK":          iMaker,
-			"EQ":          iMakerI,
-			"GT":          iMakerI,
-			"GE":          iMakerI,
-			"LT":          iMakerI,
-			"LE":          iMakerI,
-			"Divides":     iMakerI,
-			"IsAMultiple": iMakerI,
-			"Between":     iMakerII,
-			"Not":         iMakerIcheckerString,
-			"And":         iMakerMultiIchecker,
-			"Or":          iMakerMultiIchecker,
-		})
-	if err != nil {
-		panic(err)
-	}
-
-	_, err = MakeParser(
-		Int64CheckerName,
-		map[string]MakerInfo[int64]{
-			"OK":          i64Maker,
-			"EQ":          i64MakerI64,
-			"GT":          i64MakerI64,
-			"GE":          i64MakerI64,
-			"LT":          i64MakerI64,
-			"LE":          i64MakerI64,
-			"Divides":     i64MakerI64,
-			"IsAMultiple": i64MakerI64,
-			"Between":     i64MakerI64I64,
-			"Not":         i64MakerI64checkerString,
-			"And":         i64MakerMultiI64checker,
-			"Or":          i64MakerMultiI64checker,
-		})
-	if err != nil {
-		panic(err)
-	}
-
-	_, err = MakeParser(
-		StringCheckerName,
-		map[string]MakerInfo[string]{
-			"OK":             strMaker,
-			"EQ":             strMakerStr,
-			"GT":             strMakerStr,
-			"GE":             strMakerStr,
-			"LT":             strMakerStr,
-			"LE":             strMakerStr,
-			"HasPrefix":      strMakerStr,
-			"HasSuffix":      strMakerStr,
-			"Length":         strMakerIchecker,
-			"MatchesPattern": strMakerRegexpStr,
-			"Not":            strMakerStrcheckerString,
-			"And":            strMakerMultiStrchecker,
-			"Or":             strMakerMultiStrchecker,
-		})
-	if err != nil {
-		panic(err)
-	}
-
-	_, err = MakeParser(
-		StringSliceCheckerName,
-		map[string]MakerInfo[[]string]{
-			"OK":         strSlcMaker,
-			"NoDups":     strSlcMaker,
-			"Length":     strSlcMakerIchecker,
-			"Not":        strSlcMakerStrSlccheckerString,
-			"SliceAny":   strSlcMakerStrcheckerString,
-			"SliceAll":   strSlcMakerStrchecker,
-			"SliceByPos": strSlcMakerMultiStrchecker,
-			"And":        strSlcMakerMultiStrSlcchecker,
-			"Or":         strSlcMakerMultiStrSlcchecker,
-		})
-	if err != nil {
-		panic(err)
-	}
-}
-
 // anyParser is a minimal interface that can be satisfied by any Parser
 // because it does not take or return any type-specific values
 type anyParser interface {
@@ -110,6 +13,9 @@ type anyParser interface {
 	Makers() []string
 	Args(string) ([]string, error)
 	MakerFuncs() map[string][]string
+	NamedChecks() map[string]string
+	Constants() map[string]any
+	Units() map[string]float64
 }
 
 // parserRegister records the parsers that we have created. Note that it
@@ -1,6 +1,9 @@
 package checksetter
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 // reportUnknownFuncErr checks that the error is not nil and has the right
 // value and reports an error if not
@@ -62,6 +65,30 @@ func getParserRegisterEntry[T any](t *testing.T, cName string) *Parser[T] {
 func TestMakerUnknownFunc(t *testing.T) {
 	checked := initAllParsersCheckedRegister()
 
+	{
+		p := getParserRegisterEntry[bool](t, BoolCheckerName)
+
+		makers := p.Makers()
+		for _, fName := range makers {
+			mi := p.makers[fName]
+			_, err := mi.MF(nil, "nonesuch")
+			reportUnknownFuncErr(t, err, p.checkerName, fName)
+		}
+
+		checked[p.checkerName] = true
+	}
+	{
+		p := getParserRegisterEntry[time.Duration](t, DurationCheckerName)
+
+		makers := p.Makers()
+		for _, fName := range makers {
+			mi := p.makers[fName]
+			_, err := mi.MF(nil, "nonesuch")
+			reportUnknownFuncErr(t, err, p.checkerName, fName)
+		}
+
+		checked[p.checkerName] = true
+	}
 	{
 		p := getParserRegisterEntry[float64](t, Float64CheckerName)
 
@@ -122,6 +149,18 @@ func TestMakerUnknownFunc(t *testing.T) {
 
 		checked[p.checkerName] = true
 	}
+	{
+		p := getParserRegisterEntry[string](t, PathCheckerName)
+
+		makers := p.Makers()
+		for _, fName := range makers {
+			mi := p.makers[fName]
+			_, err := mi.MF(nil, "nonesuch")
+			reportUnknownFuncErr(t, err, p.checkerName, fName)
+		}
+
+		checked[p.checkerName] = true
+	}
 
 	confirmAllParsersChecked(t, checked)
 }
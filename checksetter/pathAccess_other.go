@@ -0,0 +1,23 @@
+//go:build !unix
+
+package checksetter
+
+import "os"
+
+// pathAccessible returns a non-nil error if the file-system object cannot
+// be read or, if forWriting is true, written by this process. This is
+// tested by opening the object which means that, on this platform, a
+// directory is never reported as writable.
+func pathAccessible(name string, forWriting bool) error {
+	flag := os.O_RDONLY
+	if forWriting {
+		flag = os.O_WRONLY
+	}
+
+	f, err := os.OpenFile(name, flag, 0) //nolint:gosec
+	if err != nil {
+		return err
+	}
+
+	return f.Close()
+}
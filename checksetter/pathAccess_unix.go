@@ -0,0 +1,16 @@
+//go:build unix
+
+package checksetter
+
+import "golang.org/x/sys/unix"
+
+// pathAccessible returns a non-nil error if the file-system object cannot
+// be read or, if forWriting is true, written by this process.
+func pathAccessible(name string, forWriting bool) error {
+	mode := uint32(unix.R_OK)
+	if forWriting {
+		mode = unix.W_OK
+	}
+
+	return unix.Access(name, mode)
+}
@@ -2,59 +2,172 @@ package checksetter
 
 import (
 	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
 
 	"github.com/nickwells/check.mod/v2/check"
 	"github.com/nickwells/param.mod/v7/psetter"
 )
 
+// fileParamPrefix is the prefix of a parameter value which gives the name
+// of a file to read the checks from
+const fileParamPrefix = "@"
+
+// fileAllowedValues describes how to read the checks from a file
+const fileAllowedValues = "The checks can also be read from a file by" +
+	" giving '" + fileParamPrefix + "' followed by the name of the file." +
+	" In the file the checks can be given one per line, a check can be" +
+	" split over several lines by ending each line but the last with a" +
+	" ',', '(' or an operator, and anything after '//' on a line is" +
+	" treated as a comment."
+
 // Setter satisfies the param.Setter interface. Important points of
 // difference are that you need to provide both the Parser (use the
-// checksetter.FindParserOrPanic func) and the Value when initialising the
-// Setter. Also, you will need to pass the address of the Setter rather than
-// the Setter itself, this is because the SetWithVal method takes a pointer
-// receiver.
+// checksetter.FindParserOrPanic func) and the Value or the CheckList (or
+// both) when initialising the Setter. Also, you will need to pass the
+// address of the Setter rather than the Setter itself, this is because the
+// SetWithVal method takes a pointer receiver.
 type Setter[T any] struct {
 	psetter.ValueReqMandatory
 	Parser *Parser[T]
 
 	Value *[]check.ValCk[T]
 
+	// CheckList, if set, is populated with the checks and the source text
+	// of each check so that they can be applied with the CheckList
+	// methods.
+	CheckList *CheckList[T]
+
+	// Analyse, if set, causes the checks to be analysed (see
+	// Parser.Analyse) when the value is set. Checks which no value could
+	// pass are rejected and any warnings can be found with the Warnings
+	// method.
+	Analyse bool
+
 	paramVal string
 	valSet   bool
+	warnings []string
+}
+
+// parseParamVal parses the value given to a Setter or a SingleSetter. If
+// the value starts with a '@' then the rest of the value is taken as the
+// name of a file from which the checks are read. The checks are parsed
+// once and, if analyse is set, the parsed checks are also analysed (see
+// Parser.Analyse) and rejected if no value could pass them. It returns the
+// checks, the expressions they were made from and any warnings.
+func (p Parser[T]) parseParamVal(paramVal string, analyse bool) (
+	CheckList[T], []ast.Expr, []string, error,
+) {
+	src, fileName := paramVal, ""
+
+	if name, ok := strings.CutPrefix(paramVal, fileParamPrefix); ok {
+		var err error
+
+		fileName = name
+		if src, err = p.readFileSrc(fileName); err != nil {
+			return CheckList[T]{}, nil, nil, err
+		}
+	}
+
+	elts, fset, err := p.srcElts(src, fileName)
+	if err != nil {
+		return CheckList[T]{}, nil, nil, err
+	}
+
+	// errors and warnings only give the position of the check when the
+	// checks are read from a file
+	var errFset *token.FileSet
+	if fileName != "" {
+		errFset = fset
+	}
+
+	cl, err := p.mkCheckList(src, elts, fset, errFset)
+	if err != nil {
+		return CheckList[T]{}, nil, nil, err
+	}
+
+	var warnings []string
+
+	if analyse {
+		if warnings, err = p.analyseElts(elts, errFset); err != nil {
+			return CheckList[T]{}, nil, nil, err
+		}
+	}
+
+	return cl, elts, warnings, nil
 }
 
 // SetWithVal (called when a value follows the parameter) splits the value
-// into a slice of check.Int64's and sets the Value accordingly.
+// into a slice of check funcs and sets the Value and the CheckList
+// accordingly. If the value starts with a '@' then the rest of the value is
+// taken as the name of a file from which the checks are read (see
+// Parser.ParseFile). If the Analyse field is set the checks are also
+// analysed and rejected if no value could pass them.
 func (s *Setter[T]) SetWithVal(_ string, paramVal string) error {
-	v, err := s.Parser.Parse(paramVal)
+	cl, _, warnings, err := s.Parser.parseParamVal(paramVal, s.Analyse)
 	if err != nil {
 		return err
 	}
 
-	*s.Value = v
+	if s.Value != nil {
+		*s.Value = cl.Checks
+	}
+
+	if s.CheckList != nil {
+		*s.CheckList = cl
+	}
+
 	s.paramVal = paramVal
 	s.valSet = true
+	s.warnings = warnings
 
 	return nil
 }
 
+// Warnings returns the warnings found when the checks were last analysed.
+// Checks are only analysed if the Analyse field is set.
+func (s Setter[T]) Warnings() []string {
+	return s.warnings
+}
+
 // AllowedValues returns a description of the allowed values. It includes the
 // separator to be used
 func (s Setter[T]) AllowedValues() string {
-	return AllowedValues(s.Parser.CheckerName(), s.Parser.MakerFuncs())
+	return s.Parser.setterAllowedValues()
+}
+
+// setterAllowedValues returns the description of the allowed values of a
+// Setter using the Parser
+func (p Parser[T]) setterAllowedValues() string {
+	av := AllowedValues(p.CheckerName(), p.MakerFuncs()) +
+		"\n\n" + fileAllowedValues
+
+	if p.AllowsOperators() {
+		av += "\n\n" + operatorsAllowedValues
+	}
+
+	return av
 }
 
 // CurrentValue returns the current setting of the parameter value
 func (s Setter[T]) CurrentValue() string {
 	val := ""
 
-	switch len(*s.Value) {
+	count := 0
+	if s.Value != nil {
+		count = len(*s.Value)
+	} else if s.CheckList != nil {
+		count = s.CheckList.Len()
+	}
+
+	switch count {
 	case 0:
 		val = "no checks"
 	case 1:
 		val = "one check"
 	default:
-		val = fmt.Sprintf("%d checks", len(*s.Value))
+		val = fmt.Sprintf("%d checks", count)
 	}
 
 	if s.valSet {
@@ -64,10 +177,10 @@ func (s Setter[T]) CurrentValue() string {
 	return val
 }
 
-// CheckSetter panics if the setter has not been properly created - if the
-// Value is nil or the Parser is nil.
+// CheckSetter panics if the setter has not been properly created - if both
+// the Value and the CheckList are nil or the Parser is nil.
 func (s Setter[T]) CheckSetter(name string) {
-	if s.Value == nil {
+	if s.Value == nil && s.CheckList == nil {
 		var v T
 
 		panic(
@@ -103,12 +103,22 @@ func TestSetter(t *testing.T) {
 				" checksetter.Setter[checksetter_test.setterTestType]-good " +
 				"functions separated by ','." +
 				" Write the checks as if you were writing code." +
+				" A check can be given a name by writing 'name: check';" +
+				" the name can then be used in place of the check" +
+				" elsewhere in the list." +
 				" The functions recognised are:" +
 				"\n\n" +
 				"    checksetter.Setter[checksetter_test.setterTestType]-good" +
 				" functions:" +
 				"\n" +
-				"        OK()",
+				"        OK()" +
+				"\n\n" +
+				"The checks can also be read from a file by giving '@'" +
+				" followed by the name of the file. In the file the checks" +
+				" can be given one per line, a check can be split over" +
+				" several lines by ending each line but the last with a" +
+				" ',', '(' or an operator, and anything after '//' on a" +
+				" line is treated as a comment.",
 		},
 	}
 
@@ -0,0 +1,152 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/param.mod/v7/psetter"
+)
+
+// SingleSetter satisfies the param.Setter interface. It differs from the
+// Setter in that the checks are combined into a single check func which
+// passes only if all the checks pass. As with the Setter, you need to
+// provide both the Parser and the Value when initialising the SingleSetter
+// and pass its address rather than the SingleSetter itself.
+//
+// If the Value points to a nil check func when the SingleSetter is checked
+// (see CheckSetter) it is set to a check func which always passes, as it
+// is if an empty list of checks is given, so it is always safe to call.
+type SingleSetter[T any] struct {
+	psetter.ValueReqMandatory
+	Parser *Parser[T]
+
+	Value *check.ValCk[T]
+
+	// Analyse, if set, causes the checks to be analysed (see
+	// Parser.Analyse) when the value is set. Checks which no value could
+	// pass are rejected and any warnings can be found with the Warnings
+	// method.
+	Analyse bool
+
+	expr     string
+	valSet   bool
+	warnings []string
+}
+
+// canonicalExpr returns the checks in the list combined into a single
+// expression with any named checks in the list replaced by the checks they
+// name. Checks written with operators are given as the equivalent
+// functions.
+func (p Parser[T]) canonicalExpr(elts []ast.Expr) string {
+	exprs, defs, err := p.splitLocalDefs(elts)
+	if err != nil {
+		return ""
+	}
+
+	parts := make([]string, 0, len(exprs))
+	for _, e := range exprs {
+		parts = append(parts, types.ExprString(p.expandLocalDefs(e, defs)))
+	}
+
+	switch len(parts) {
+	case 0:
+		return "OK"
+	case 1:
+		return parts[0]
+	}
+
+	return "And(" + strings.Join(parts, ", ") + ")"
+}
+
+// combineChecks returns a single check func which passes if all the checks
+// pass
+func combineChecks[T any](checks []check.ValCk[T]) check.ValCk[T] {
+	switch len(checks) {
+	case 0:
+		return check.ValOK[T]
+	case 1:
+		return checks[0]
+	}
+
+	return check.And(checks...)
+}
+
+// SetWithVal (called when a value follows the parameter) parses the value
+// as a list of checks and sets the Value to a single check func combining
+// them. As for the Setter, if the value starts with a '@' then the rest of
+// the value is taken as the name of a file from which the checks are read
+// and if the Analyse field is set the checks are also analysed and
+// rejected if no value could pass them.
+func (s *SingleSetter[T]) SetWithVal(_ string, paramVal string) error {
+	cl, elts, warnings, err := s.Parser.parseParamVal(paramVal, s.Analyse)
+	if err != nil {
+		return err
+	}
+
+	*s.Value = combineChecks(cl.Checks)
+	s.expr = s.Parser.canonicalExpr(elts)
+	s.valSet = true
+	s.warnings = warnings
+
+	return nil
+}
+
+// Warnings returns the warnings found when the checks were last analysed.
+// Checks are only analysed if the Analyse field is set.
+func (s SingleSetter[T]) Warnings() []string {
+	return s.warnings
+}
+
+// AllowedValues returns a description of the allowed values. It includes the
+// separator to be used
+func (s SingleSetter[T]) AllowedValues() string {
+	return s.Parser.setterAllowedValues()
+}
+
+// CurrentValue returns the current setting of the parameter value. Once
+// the value has been set this is the combined check, for instance,
+// 'And(GT(0), LT(10))'.
+func (s SingleSetter[T]) CurrentValue() string {
+	if !s.valSet {
+		return "no checks"
+	}
+
+	return s.expr
+}
+
+// CheckSetter panics if the setter has not been properly created - if the
+// Value is nil or the Parser is nil. If the Value points to a nil check
+// func it is set to a check func which always passes.
+func (s SingleSetter[T]) CheckSetter(name string) {
+	if s.Value == nil {
+		var v T
+
+		panic(
+			psetter.NilValueMessage(name,
+				fmt.Sprintf("checksetter.SingleSetter[%T]", v)))
+	}
+
+	if s.Parser == nil {
+		var v T
+
+		panic(fmt.Sprintf(
+			"The Parser for checksetter.SingleSetter[%T] has not been set",
+			v))
+	}
+
+	if len(s.Parser.Makers()) == 0 {
+		var v T
+
+		panic(fmt.Sprintf(
+			"The Parser for checksetter.SingleSetter[%T]"+
+				" can't make any check-funcs",
+			v))
+	}
+
+	if *s.Value == nil {
+		*s.Value = check.ValOK[T]
+	}
+}
@@ -0,0 +1,173 @@
+package checksetter_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSingleSetterCheckSetter(t *testing.T) {
+	const setterTypeName = "checksetter.SingleSetter[int]"
+
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	var cf check.ValCk[int]
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpPanic
+		s checksetter.SingleSetter[int]
+	}{
+		{
+			ID: testhelper.MkID("nil value"),
+			ExpPanic: testhelper.MkExpPanic(
+				setterTypeName + " Check failed: the Value to be set is nil"),
+			s: checksetter.SingleSetter[int]{Parser: p},
+		},
+		{
+			ID: testhelper.MkID("nil Parser"),
+			ExpPanic: testhelper.MkExpPanic(
+				"The Parser for " + setterTypeName + " has not been set"),
+			s: checksetter.SingleSetter[int]{Value: &cf},
+		},
+		{
+			ID: testhelper.MkID("good"),
+			s:  checksetter.SingleSetter[int]{Value: &cf, Parser: p},
+		},
+	}
+
+	for _, tc := range testCases {
+		panicked, panicVal := testhelper.PanicSafe(
+			func() {
+				tc.s.CheckSetter(tc.IDStr())
+			})
+		testhelper.CheckExpPanic(t, panicked, panicVal, tc)
+	}
+
+	if cf == nil {
+		t.Fatal("CheckSetter should set a nil check func to one that passes")
+	}
+
+	if err := cf(42); err != nil {
+		t.Error("the default check func should pass: ", err)
+	}
+}
+
+func TestSingleSetter(t *testing.T) {
+	p := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		p        *checksetter.Parser[int]
+		val      string
+		expCrnt  string
+		passVals []int
+		failVals []int
+	}{
+		{
+			ID:       testhelper.MkID("several checks"),
+			p:        p,
+			val:      "GT(0), LT(10)",
+			expCrnt:  "And(GT(0), LT(10))",
+			passVals: []int{1, 9},
+			failVals: []int{0, 10},
+		},
+		{
+			ID:       testhelper.MkID("one check"),
+			p:        p,
+			val:      "Between(1, 5)",
+			expCrnt:  "Between(1, 5)",
+			passVals: []int{1, 5},
+			failVals: []int{0, 6},
+		},
+		{
+			ID:       testhelper.MkID("no checks"),
+			p:        p,
+			val:      "",
+			expCrnt:  "OK",
+			passVals: []int{-1, 0, 1},
+		},
+		{
+			ID:       testhelper.MkID("named checks"),
+			p:        p,
+			val:      "small: LT(10), small, GT(0)",
+			expCrnt:  "And(LT(10), GT(0))",
+			passVals: []int{5},
+			failVals: []int{0, 10},
+		},
+		{
+			ID:       testhelper.MkID("operators"),
+			p:        p.WithOperators(),
+			val:      ">= 1 && != 3",
+			expCrnt:  `And(GE(1), Not(EQ(3), "equal to 3"))`,
+			passVals: []int{1, 4},
+			failVals: []int{0, 3},
+		},
+		{
+			ID:  testhelper.MkID("file"),
+			p:   p,
+			val: "@" + filepath.Join(checkFileDir, "good.chk"),
+			expCrnt: `And(GE(1024), LE(65535),` +
+				` Not(EQ(8080), "the HTTP port // not a comment"))`,
+			passVals: []int{1024, 65535},
+			failVals: []int{1023, 8080},
+		},
+		{
+			ID:      testhelper.MkID("bad checks"),
+			ExpErr:  testhelper.MkExpErr("can't make int-checker function"),
+			p:       p,
+			val:     "Nonesuch",
+			expCrnt: "no checks",
+		},
+	}
+
+	for _, tc := range testCases {
+		var cf check.ValCk[int]
+
+		s := checksetter.SingleSetter[int]{Parser: tc.p, Value: &cf}
+		s.CheckSetter(tc.IDStr())
+
+		err := s.SetWithVal("", tc.val)
+		testhelper.CheckExpErr(t, err, tc)
+		testhelper.DiffString(t, tc.IDStr(), "current value",
+			s.CurrentValue(), tc.expCrnt)
+
+		for _, v := range tc.passVals {
+			if err := cf(v); err != nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: %d should pass: %s", v, err)
+			}
+		}
+
+		for _, v := range tc.failVals {
+			if cf(v) == nil {
+				t.Log(tc.IDStr())
+				t.Errorf("\t: %d should fail", v)
+			}
+		}
+	}
+}
+
+func TestSingleSetterAnalyse(t *testing.T) {
+	var cf check.ValCk[int]
+
+	s := checksetter.SingleSetter[int]{
+		Parser:  checksetter.FindParserOrPanic[int](checksetter.IntCheckerName),
+		Value:   &cf,
+		Analyse: true,
+	}
+
+	err := s.SetWithVal("", "GT(10), LT(5)")
+	testhelper.DiffBool(t, "unsatisfiable", "has error", err != nil, true)
+
+	err = s.SetWithVal("", "GT(0), GT(1)")
+	if err != nil {
+		t.Fatal("unexpected error: ", err)
+	}
+
+	testhelper.DiffInt(t, "redundant", "warnings", len(s.Warnings()), 1)
+}
@@ -0,0 +1,32 @@
+# The bool-checker family. makerBool.go and makerBool_test.go are generated
+# from this by mkchecker (see the go:generate directive in generate.go).
+
+file    makerBool.go
+family  bool-checker BoolCheckerName bool b B
+desc    bool values
+
+maker
+func    OK check.ValOK[bool]
+func    IsTrue boolIsTrue
+func    IsFalse boolIsFalse
+
+maker   bool-checker string
+func    Not check.Not[bool]
+
+maker   ... bool-checker
+func    And check.And[bool]
+func    Or check.Or[bool]
+func    Xor valXor[bool]
+func    None valNone[bool]
+
+maker   count ... bool-checker
+func    AtLeast valAtLeast[bool]
+func    AtMost valAtMost[bool]
+func    Exactly valExactly[bool]
+
+maker   bool-checker bool-checker
+func    If valIf[bool]
+func    Implies valImplies[bool]
+
+maker   bool-checker bool-checker bool-checker
+func    IfElse valIfElse[bool]
@@ -0,0 +1,47 @@
+# The duration-checker family. makerDuration.go and makerDuration_test.go
+# are generated from this by mkchecker (see the go:generate directive in
+# generate.go).
+
+file    makerDuration.go
+family  duration-checker DurationCheckerName time.Duration dur Dur
+desc    time.Duration values
+import  time
+
+maker
+func    OK check.ValOK[time.Duration]
+
+maker   duration
+func    EQ check.ValEQ[time.Duration]
+func    GT check.ValGT[time.Duration]
+func    GE check.ValGE[time.Duration]
+func    LT check.ValLT[time.Duration]
+func    LE check.ValLE[time.Duration]
+
+maker   duration duration
+func    Between check.ValBetween[time.Duration]
+sample  Between "1s", "2s"
+
+maker   duration-checker string
+func    Not check.Not[time.Duration]
+
+maker   ... duration
+func    OneOf valOneOf[time.Duration]
+func    NoneOf valNoneOf[time.Duration]
+
+maker   ... duration-checker
+func    And check.And[time.Duration]
+func    Or check.Or[time.Duration]
+func    Xor valXor[time.Duration]
+func    None valNone[time.Duration]
+
+maker   count ... duration-checker
+func    AtLeast valAtLeast[time.Duration]
+func    AtMost valAtMost[time.Duration]
+func    Exactly valExactly[time.Duration]
+
+maker   duration-checker duration-checker
+func    If valIf[time.Duration]
+func    Implies valImplies[time.Duration]
+
+maker   duration-checker duration-checker duration-checker
+func    IfElse valIfElse[time.Duration]
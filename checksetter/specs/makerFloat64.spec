@@ -0,0 +1,55 @@
+# The float64-checker family. makerFloat64.go and makerFloat64_test.go are
+# generated from this by mkchecker (see the go:generate directive in
+# generate.go).
+
+file    makerFloat64.go
+family  float64-checker Float64CheckerName float64 f64 F64
+desc    float64 values
+
+maker
+func    OK check.ValOK[float64]
+func    IsFinite floatIsFinite[float64]
+func    NotNaN floatNotNaN[float64]
+func    IsIntegral floatIsIntegral[float64]
+
+maker   float64
+func    GT check.ValGT[float64]
+func    GE check.ValGE[float64]
+func    LT check.ValLT[float64]
+func    LE check.ValLE[float64]
+
+maker   float64 float64
+func    Between check.ValBetween[float64]
+func    ApproxEQ floatApproxEQ[float64]
+func    RelApproxEQ floatRelApproxEQ[float64]
+sample  Between 1, 2
+sample  ApproxEQ 1, 0.1
+sample  RelApproxEQ 1, 0.1
+
+maker   int-checker
+func    Sign floatSign[float64]
+
+maker   float64-checker string
+func    Not check.Not[float64]
+
+maker   ... float64
+func    OneOf valOneOf[float64]
+func    NoneOf valNoneOf[float64]
+
+maker   ... float64-checker
+func    And check.And[float64]
+func    Or check.Or[float64]
+func    Xor valXor[float64]
+func    None valNone[float64]
+
+maker   count ... float64-checker
+func    AtLeast valAtLeast[float64]
+func    AtMost valAtMost[float64]
+func    Exactly valExactly[float64]
+
+maker   float64-checker float64-checker
+func    If valIf[float64]
+func    Implies valImplies[float64]
+
+maker   float64-checker float64-checker float64-checker
+func    IfElse valIfElse[float64]
@@ -0,0 +1,47 @@
+# The int-checker family. makerInt.go and makerInt_test.go are generated
+# from this by mkchecker (see the go:generate directive in generate.go).
+
+file    makerInt.go
+family  int-checker IntCheckerName int i I
+desc    int values
+
+maker
+func    OK check.ValOK[int]
+
+maker   int
+func    EQ check.ValEQ[int]
+func    GT check.ValGT[int]
+func    GE check.ValGE[int]
+func    LT check.ValLT[int]
+func    LE check.ValLE[int]
+func    Divides check.ValDivides[int]
+func    IsAMultiple check.ValIsAMultiple[int]
+
+maker   int int
+func    Between check.ValBetween[int]
+sample  Between 1, 2
+
+maker   int-checker string
+func    Not check.Not[int]
+
+maker   ... int
+func    OneOf valOneOf[int]
+func    NoneOf valNoneOf[int]
+
+maker   ... int-checker
+func    And check.And[int]
+func    Or check.Or[int]
+func    Xor valXor[int]
+func    None valNone[int]
+
+maker   count ... int-checker
+func    AtLeast valAtLeast[int]
+func    AtMost valAtMost[int]
+func    Exactly valExactly[int]
+
+maker   int-checker int-checker
+func    If valIf[int]
+func    Implies valImplies[int]
+
+maker   int-checker int-checker int-checker
+func    IfElse valIfElse[int]
@@ -0,0 +1,48 @@
+# The int64-checker family. makerInt64.go and makerInt64_test.go are
+# generated from this by mkchecker (see the go:generate directive in
+# generate.go).
+
+file    makerInt64.go
+family  int64-checker Int64CheckerName int64 i64 I64
+desc    int64 values
+
+maker
+func    OK check.ValOK[int64]
+
+maker   int64
+func    EQ check.ValEQ[int64]
+func    GT check.ValGT[int64]
+func    GE check.ValGE[int64]
+func    LT check.ValLT[int64]
+func    LE check.ValLE[int64]
+func    Divides check.ValDivides[int64]
+func    IsAMultiple check.ValIsAMultiple[int64]
+
+maker   int64 int64
+func    Between check.ValBetween[int64]
+sample  Between 1, 2
+
+maker   int64-checker string
+func    Not check.Not[int64]
+
+maker   ... int64
+func    OneOf valOneOf[int64]
+func    NoneOf valNoneOf[int64]
+
+maker   ... int64-checker
+func    And check.And[int64]
+func    Or check.Or[int64]
+func    Xor valXor[int64]
+func    None valNone[int64]
+
+maker   count ... int64-checker
+func    AtLeast valAtLeast[int64]
+func    AtMost valAtMost[int64]
+func    Exactly valExactly[int64]
+
+maker   int64-checker int64-checker
+func    If valIf[int64]
+func    Implies valImplies[int64]
+
+maker   int64-checker int64-checker int64-checker
+func    IfElse valIfElse[int64]
@@ -0,0 +1,51 @@
+# The path-checker family. makerPath.go and makerPath_test.go are generated
+# from this by mkchecker (see the go:generate directive in generate.go).
+#
+# The values checked are the names of file-system objects.
+
+file    makerPath.go
+family  path-checker PathCheckerName string path Path
+desc    the names of file-system objects
+import  io/fs
+
+kind    perm fs.FileMode PermArg Perm "0644"
+
+maker
+func    OK check.ValOK[string]
+func    Exists pathExists
+func    NotExists pathNotExists
+func    IsDir pathIsDir
+func    IsRegular pathIsRegular
+func    IsSymlink pathIsSymlink
+func    Readable pathReadable
+func    Writable pathWritable
+
+maker   perm
+func    HasPerm pathHasPerm
+
+maker   int64
+func    SizeLE pathSizeLE
+
+maker   duration
+func    ModifiedWithin pathModifiedWithin
+
+maker   path-checker string
+func    Not check.Not[string]
+
+maker   ... path-checker
+func    And check.And[string]
+func    Or check.Or[string]
+func    Xor valXor[string]
+func    None valNone[string]
+
+maker   count ... path-checker
+func    AtLeast valAtLeast[string]
+func    AtMost valAtMost[string]
+func    Exactly valExactly[string]
+
+maker   path-checker path-checker
+func    If valIf[string]
+func    Implies valImplies[string]
+
+maker   path-checker path-checker path-checker
+func    IfElse valIfElse[string]
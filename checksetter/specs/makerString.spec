@@ -0,0 +1,105 @@
+# The string-checker family. makerString.go and makerString_test.go are
+# generated from this by mkchecker (see the go:generate directive in
+# generate.go).
+
+file    makerString.go
+family  string-checker StringCheckerName string str Str
+desc    string values
+import  time
+import  net/netip
+
+kind    cidr netip.Prefix CIDRArg CIDR "10.0.0.0/8"
+kind    semver string SemverArg Semver "1.4.0"
+
+maker
+func    OK check.ValOK[string]
+func    IsUpper stringIsUpper[string]
+func    IsLower stringIsLower[string]
+func    IsASCII stringIsASCII[string]
+func    IsPrintable stringIsPrintable[string]
+func    ValidUTF8 stringValidUTF8[string]
+func    NoSurroundingSpace stringNoSurroundingSpace[string]
+func    IsIP stringIsIP[string]
+func    IsIPv4 stringIsIPv4[string]
+func    IsIPv6 stringIsIPv6[string]
+func    IsHostname stringIsHostname[string]
+func    IsHostPort stringIsHostPort[string]
+func    IsEmail stringIsEmail[string]
+func    IsSemver stringIsSemver[string]
+func    SemverNoPrerelease stringSemverNoPrerelease[string]
+
+maker   string
+func    EQ check.ValEQ[string]
+func    GT check.ValGT[string]
+func    GE check.ValGE[string]
+func    LT check.ValLT[string]
+func    LE check.ValLE[string]
+func    HasPrefix check.StringHasPrefix[string]
+func    HasSuffix check.StringHasSuffix[string]
+func    Contains check.StringContains[string]
+func    ContainsAny stringContainsAny[string]
+func    EqualFold check.StringFoldedEQ[string]
+func    CharsIn stringCharsIn[string]
+
+maker   int-checker
+func    Length check.StringLength[string]
+func    RuneCount stringRuneCount[string]
+func    AsInt stringAsInt[string]
+func    Port stringPort[string]
+func    SemverMajor stringSemverMajor[string]
+func    SemverMinor stringSemverMinor[string]
+func    SemverPatch stringSemverPatch[string]
+
+maker   float64-checker
+func    AsFloat stringAsFloat[string]
+
+maker   duration-checker
+func    AsDuration stringAsDuration[string]
+
+maker   bool-checker
+func    AsBool stringAsBool[string]
+
+maker   semver
+func    SemverEQ stringSemverEQ[string]
+func    SemverGT stringSemverGT[string]
+func    SemverGE stringSemverGE[string]
+func    SemverLT stringSemverLT[string]
+func    SemverLE stringSemverLE[string]
+
+maker   cidr
+func    InCIDR stringInCIDR[string]
+
+maker   string-checker
+func    URLHost stringURLHost[string]
+
+maker   path-checker
+func    AsPath stringAsPath[string]
+
+maker   regexp string
+func    MatchesPattern check.StringMatchesPattern[string]
+
+maker   string-checker string
+func    Not check.Not[string]
+
+maker   ... string
+func    OneOf valOneOf[string]
+func    NoneOf valNoneOf[string]
+func    IsURL stringIsURL[string]
+
+maker   ... string-checker
+func    And check.And[string]
+func    Or check.Or[string]
+func    Xor valXor[string]
+func    None valNone[string]
+
+maker   count ... string-checker
+func    AtLeast valAtLeast[string]
+func    AtMost valAtMost[string]
+func    Exactly valExactly[string]
+
+maker   string-checker string-checker
+func    If valIf[string]
+func    Implies valImplies[string]
+
+maker   string-checker string-checker string-checker
+func    IfElse valIfElse[string]
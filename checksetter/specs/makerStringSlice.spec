@@ -0,0 +1,44 @@
+# The string-slice-checker family. makerStringSlice.go and
+# makerStringSlice_test.go are generated from this by mkchecker (see the
+# go:generate directive in generate.go).
+
+file    makerStringSlice.go
+family  string-slice-checker StringSliceCheckerName []string strSlc StrSlc
+desc    slices of strings
+
+maker
+func    OK check.ValOK[[]string]
+func    NoDups check.SliceHasNoDups[[]string]
+
+maker   int-checker
+func    Length check.SliceLength[[]string]
+
+maker   string-slice-checker string
+func    Not check.Not[[]string]
+
+maker   string-checker string
+func    SliceAny check.SliceAny[[]string]
+
+maker   string-checker
+func    SliceAll check.SliceAll[[]string]
+
+maker   ... string-checker
+func    SliceByPos check.SliceByPos[[]string]
+
+maker   ... string-slice-checker
+func    And check.And[[]string]
+func    Or check.Or[[]string]
+func    Xor valXor[[]string]
+func    None valNone[[]string]
+
+maker   count ... string-slice-checker
+func    AtLeast valAtLeast[[]string]
+func    AtMost valAtMost[[]string]
+func    Exactly valExactly[[]string]
+
+maker   string-slice-checker string-slice-checker
+func    If valIf[[]string]
+func    Implies valImplies[[]string]
+
+maker   string-slice-checker string-slice-checker string-slice-checker
+func    IfElse valIfElse[[]string]
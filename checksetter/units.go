@@ -0,0 +1,233 @@
+package checksetter
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"maps"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// defaultUnits returns the units that every Parser starts with: the SI
+// (decimal) and IEC (binary) multipliers, with and without a 'B' for
+// bytes, and '%'
+func defaultUnits() map[string]float64 {
+	units := map[string]float64{
+		"B": 1,
+		"%": 0.01,
+	}
+
+	for i, prefix := range []string{"k", "M", "G", "T", "P"} {
+		si := math.Pow(1000, float64(i+1))  //nolint:mnd
+		iec := math.Pow(1024, float64(i+1)) //nolint:mnd
+		iecPrefix := strings.ToUpper(prefix) + "i"
+
+		units[prefix] = si
+		units[prefix+"B"] = si
+		units[iecPrefix] = iec
+		units[iecPrefix+"B"] = iec
+	}
+
+	return units
+}
+
+// AddUnit registers a unit with the Parser. A numeric argument to any of
+// this Parser's functions can then be given as a string holding a number
+// followed by the unit name, for instance "1.5k" or "512MiB", and the
+// number is multiplied by the multiplier. A number must be given before the
+// unit. Counts, such as the number of checks given to AtLeast, cannot be
+// given with a unit. Every Parser starts with the SI (k, M, G, T, P) and
+// IEC (Ki, Mi, Gi, Ti, Pi) units, the same units followed by 'B' (for
+// bytes), 'B' itself and '%' (0.01).
+//
+// It will return an error if the name is already a unit, is not made up of
+// letters and '%' characters or if the multiplier is not a positive, finite
+// number.
+func (p Parser[T]) AddUnit(name string, multiplier float64) error {
+	if name == "" ||
+		strings.IndexFunc(name,
+			func(r rune) bool { return !unicode.IsLetter(r) && r != '%' }) != -1 {
+		return fmt.Errorf("bad name for a unit: %q"+
+			" (it must only have letters or '%%')", name)
+	}
+
+	if _, ok := p.units[name]; ok {
+		return fmt.Errorf("bad name for a unit: %q (it is already a unit)",
+			name)
+	}
+
+	if !(multiplier > 0) || math.IsInf(multiplier, 1) {
+		return fmt.Errorf("bad multiplier for the unit %q: %v"+
+			" (it must be a positive, finite number)", name, multiplier)
+	}
+
+	p.units[name] = multiplier
+
+	return nil
+}
+
+// RemoveUnit removes the named unit from the Parser. It will return an
+// error if there is no such unit.
+func (p Parser[T]) RemoveUnit(name string) error {
+	if _, ok := p.units[name]; !ok {
+		return fmt.Errorf("there is no unit called %q", name)
+	}
+
+	delete(p.units, name)
+
+	return nil
+}
+
+// Units returns a map of the names of the units to their multipliers.
+//
+// This can be used to construct the Allowed Values message for a setter.
+func (p Parser[T]) Units() map[string]float64 {
+	return maps.Clone(p.units)
+}
+
+// numericArgBits maps the names of the numeric argument kinds which can be
+// given with a unit to the number of bits in the corresponding integer
+// type; a value of zero means that the kind is a floating point number.
+// Counts (see CountArg) are not included.
+var numericArgBits = map[string]int{
+	IntArg.Name:     strconv.IntSize,
+	Int64Arg.Name:   64, //nolint:mnd
+	Float64Arg.Name: 0,
+}
+
+// isUnitNumber returns true if the string can be the number part of a
+// value with a unit
+func isUnitNumber(s string) bool {
+	if strings.ContainsRune(s, '/') {
+		return false
+	}
+
+	_, ok := new(big.Rat).SetString(s)
+
+	return ok
+}
+
+// unitValue converts the string into the value it represents using the
+// Parser's units. It returns false if the string is not a number followed
+// by a unit. Where more than one unit matches, the longest is used.
+func (p Parser[T]) unitValue(s string) (*big.Rat, bool) {
+	unit := ""
+
+	for name := range p.units {
+		numStr, ok := strings.CutSuffix(s, name)
+		if ok && len(name) > len(unit) && isUnitNumber(numStr) {
+			unit = name
+		}
+	}
+
+	if unit == "" {
+		return nil, false
+	}
+
+	v, _ := new(big.Rat).SetString(strings.TrimSuffix(s, unit))
+
+	mult, _ := new(big.Rat).SetString(
+		strconv.FormatFloat(p.units[unit], 'g', -1, 64))
+
+	return v.Mul(v, mult), true
+}
+
+// unitLit converts the value into a literal of the given numeric kind. It
+// returns an error if the value cannot be represented by that kind.
+func unitLit(s string, v *big.Rat, bits int, pos token.Pos) (
+	*ast.BasicLit, error,
+) {
+	if bits == 0 {
+		f, _ := v.Float64()
+		if math.IsInf(f, 0) {
+			return nil, fmt.Errorf("%q is too big for a float64", s)
+		}
+
+		return constantLit(f, pos), nil
+	}
+
+	if !v.IsInt() {
+		return nil, fmt.Errorf("%q is not a whole number", s)
+	}
+
+	n := v.Num()
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+
+	if n.Cmp(limit) >= 0 {
+		return nil, fmt.Errorf("%q is too big for an int%d", s, bits)
+	}
+
+	if n.Cmp(limit.Neg(limit)) < 0 {
+		return nil, fmt.Errorf("%q is too small for an int%d", s, bits)
+	}
+
+	return &ast.BasicLit{ValuePos: pos, Kind: token.INT, Value: n.String()},
+		nil
+}
+
+// substituteUnits returns the CallExpr with any string literals holding
+// numbers with units replaced by the corresponding numeric literals. Only
+// those arguments where a number is expected are replaced. If there is
+// nothing to replace the CallExpr is returned unchanged, otherwise a copy
+// is returned. An error is returned if a value cannot be represented by
+// the type of the argument.
+func (p Parser[T]) substituteUnits(e *ast.CallExpr, args []string) (
+	*ast.CallExpr, error,
+) {
+	if e == nil || len(p.units) == 0 {
+		return e, nil
+	}
+
+	var newCall *ast.CallExpr
+
+	for i, arg := range e.Args {
+		str, ok := arg.(*ast.BasicLit)
+		if !ok || str.Kind != token.STRING {
+			continue
+		}
+
+		s, err := strconv.Unquote(str.Value)
+		if err != nil {
+			continue
+		}
+
+		kind, ok := argKindAt(args, i)
+		if !ok {
+			continue
+		}
+
+		bits, ok := numericArgBits[kind]
+		if !ok {
+			continue
+		}
+
+		v, ok := p.unitValue(s)
+		if !ok {
+			continue
+		}
+
+		lit, err := unitLit(s, v, bits, arg.Pos())
+		if err != nil {
+			return nil, err
+		}
+
+		if newCall == nil {
+			c := *e
+			c.Args = slices.Clone(e.Args)
+			newCall = &c
+		}
+
+		newCall.Args[i] = lit
+	}
+
+	if newCall == nil {
+		return e, nil
+	}
+
+	return newCall, nil
+}
@@ -0,0 +1,231 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestAddUnit(t *testing.T) {
+	p, _ := mkNamedCheckTestParser(t, "TestAddUnit")
+
+	startCount := len(p.Units())
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		name string
+		mult float64
+	}{
+		{
+			ID:   testhelper.MkID("good"),
+			name: "dozen",
+			mult: 12,
+		},
+		{
+			ID:   testhelper.MkID("good: per mille"),
+			name: "%%",
+			mult: 0.001,
+		},
+		{
+			ID: testhelper.MkID("bad: duplicate"),
+			ExpErr: testhelper.MkExpErr(`bad name for a unit: "KiB"`,
+				"it is already a unit"),
+			name: "KiB",
+			mult: 1024,
+		},
+		{
+			ID: testhelper.MkID("bad: name"),
+			ExpErr: testhelper.MkExpErr(`bad name for a unit: "x2"`,
+				"it must only have letters or '%'"),
+			name: "x2",
+			mult: 2,
+		},
+		{
+			ID: testhelper.MkID("bad: multiplier"),
+			ExpErr: testhelper.MkExpErr(`bad multiplier for the unit "none": 0`,
+				"it must be a positive, finite number"),
+			name: "none",
+			mult: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := p.AddUnit(tc.name, tc.mult)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+
+	testhelper.DiffInt(t, "units", "count", len(p.Units()), startCount+2)
+
+	if err := p.RemoveUnit("dozen"); err != nil {
+		t.Error("unexpected error removing a unit: " + err.Error())
+	}
+
+	err := p.RemoveUnit("dozen")
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID:     testhelper.MkID("remove a missing unit"),
+		ExpErr: testhelper.MkExpErr(`there is no unit called "dozen"`),
+	})
+}
+
+func TestParseUnits(t *testing.T) {
+	pInt64 := checksetter.FindParserOrPanic[int64](
+		checksetter.Int64CheckerName)
+	pFloat := checksetter.FindParserOrPanic[float64](
+		checksetter.Float64CheckerName)
+	pString := checksetter.FindParserOrPanic[string](
+		checksetter.StringCheckerName)
+
+	pCustom, _ := mkNamedCheckTestParser(t, "TestParseUnits")
+	if err := pCustom.AddUnit("dozen", 12); err != nil {
+		t.Fatal("couldn't add the unit: " + err.Error())
+	}
+
+	if err := pCustom.RemoveUnit("k"); err != nil {
+		t.Fatal("couldn't remove the unit: " + err.Error())
+	}
+
+	type chkResult[T any] struct {
+		val  T
+		pass bool
+	}
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr string
+	}{
+		{
+			ID:   testhelper.MkID("int64: IEC"),
+			expr: `LE("512MiB")`,
+		},
+		{
+			ID:   testhelper.MkID("int64: SI, fractional number"),
+			expr: `GT("1.5k")`,
+		},
+		{
+			ID:   testhelper.MkID("int64: smallest value"),
+			expr: `GE("-8192PiB")`,
+		},
+		{
+			ID: testhelper.MkID("int64: unit without a number"),
+			ExpErr: testhelper.MkExpErr(
+				`EQ(int64): unknown constant: "KiB"`),
+			expr: `EQ(KiB)`,
+		},
+		{
+			ID: testhelper.MkID("int64: quoted unit without a number"),
+			ExpErr: testhelper.MkExpErr(
+				`LE(int64): "\"k\"" isn't an INT, it's a STRING`),
+			expr: `LE("k")`,
+		},
+		{
+			ID: testhelper.MkID("int64: overflow"),
+			ExpErr: testhelper.MkExpErr(
+				`LE(int64): "10000PB" is too big for an int64`),
+			expr: `LE("10000PB")`,
+		},
+		{
+			ID: testhelper.MkID("int64: largest value, overflow"),
+			ExpErr: testhelper.MkExpErr(
+				`LE(int64): "8192PiB" is too big for an int64`),
+			expr: `LE("8192PiB")`,
+		},
+		{
+			ID: testhelper.MkID("int64: underflow"),
+			ExpErr: testhelper.MkExpErr(
+				`GE(int64): "-8193PiB" is too small for an int64`),
+			expr: `GE("-8193PiB")`,
+		},
+		{
+			ID: testhelper.MkID("int64: not a whole number"),
+			ExpErr: testhelper.MkExpErr(
+				`GT(int64): "1.5B" is not a whole number`),
+			expr: `GT("1.5B")`,
+		},
+		{
+			ID: testhelper.MkID("int64: unknown unit"),
+			ExpErr: testhelper.MkExpErr(
+				`GT(int64): "\"1.5X\"" isn't an INT, it's a STRING`),
+			expr: `GT("1.5X")`,
+		},
+		{
+			ID: testhelper.MkID("int64: count with a unit"),
+			ExpErr: testhelper.MkExpErr(
+				`AtLeast(count, ..., int64-checker):`,
+				`"\"1k\"" isn't an INT, it's a STRING`),
+			expr: `AtLeast("1k", OK())`,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := pInt64.Parse(tc.expr)
+		testhelper.CheckExpErr(t, err, tc)
+	}
+
+	vcsF, err := pFloat.Parse(`Between("10%", "90%")`)
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	for _, r := range []chkResult[float64]{
+		{val: 0.1, pass: true},
+		{val: 0.9, pass: true},
+		{val: 0.09, pass: false},
+		{val: 0.91, pass: false},
+	} {
+		if err := vcsF[0](r.val); (err == nil) != r.pass {
+			t.Errorf("float64 percentages: checking %v,"+
+				" expected pass: %t, err: %v", r.val, r.pass, err)
+		}
+	}
+
+	vcsI, err := pInt64.Parse(`LE("512MiB"), GT("1.5k"), EQ("1KiB")`)
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	for i, v := range []int64{512 * 1024 * 1024, 1501, 1024} {
+		if err := vcsI[i](v); err != nil {
+			t.Errorf("int64 units: check %d: unexpected error: %s", i, err)
+		}
+	}
+
+	if err := vcsI[0](512*1024*1024 + 1); err == nil {
+		t.Error("int64 units: LE(\"512MiB\") should reject 512MiB+1")
+	}
+
+	// a string argument is not converted
+	vcsS, err := pString.Parse(`HasPrefix("10k")`)
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	if err := vcsS[0]("10k and more"); err != nil {
+		t.Error("string argument: unexpected error: " + err.Error())
+	}
+
+	// the custom unit table
+	vcsC, err := pCustom.Parse(`EQ("2dozen")`)
+	if err != nil {
+		t.Fatal("custom units: unexpected error: " + err.Error())
+	}
+
+	if err := vcsC[0](24); err != nil {
+		t.Error("custom units: unexpected error: " + err.Error())
+	}
+
+	_, err = pCustom.Parse(`EQ("2k")`)
+	testhelper.CheckExpErr(t, err, struct {
+		testhelper.ID
+		testhelper.ExpErr
+	}{
+		ID: testhelper.MkID("custom units: removed unit"),
+		ExpErr: testhelper.MkExpErr(
+			`EQ(int): "\"2k\"" isn't an INT, it's a STRING`),
+	})
+}
@@ -0,0 +1,21 @@
+package checksetter
+
+import "errors"
+
+// boolIsTrue checks that the value is true
+func boolIsTrue(v bool) error {
+	if !v {
+		return errors.New("the value should be true")
+	}
+
+	return nil
+}
+
+// boolIsFalse checks that the value is false
+func boolIsFalse(v bool) error {
+	if v {
+		return errors.New("the value should be false")
+	}
+
+	return nil
+}
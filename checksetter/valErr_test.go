@@ -0,0 +1,44 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// valErr records a value to be checked and the error expected when it is
+// checked. The expected error is empty if the value should pass the checks.
+type valErr[T any] struct {
+	val    T
+	expErr string
+}
+
+// testValErrs parses the expression with the Parser, checking the parse
+// error against that expected by the test case, and then checks each of
+// the values against the resulting checks. The error from the first check
+// to fail is compared with the expected error.
+func testValErrs[T any](t *testing.T, tc testhelper.TestCaseWithErr,
+	p *checksetter.Parser[T], expr string, valErrs []valErr[T],
+) {
+	t.Helper()
+
+	vcs, err := p.Parse(expr)
+	if !testhelper.CheckExpErr(t, err, tc) || err != nil {
+		return
+	}
+
+	for _, ve := range valErrs {
+		actErr := ""
+
+		for _, vc := range vcs {
+			if err := vc(ve.val); err != nil {
+				actErr = err.Error()
+				break
+			}
+		}
+
+		testhelper.DiffString(t, tc.IDStr(), "check error",
+			actErr, ve.expErr)
+	}
+}
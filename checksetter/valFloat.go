@@ -0,0 +1,108 @@
+package checksetter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// floatIsFinite checks that the value is neither infinite nor NaN
+func floatIsFinite[T ~float64](v T) error {
+	if math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+		return fmt.Errorf("the value (%v) should be finite", v)
+	}
+
+	return nil
+}
+
+// floatNotNaN checks that the value is not NaN
+func floatNotNaN[T ~float64](v T) error {
+	if math.IsNaN(float64(v)) {
+		return errors.New("the value should not be NaN")
+	}
+
+	return nil
+}
+
+// floatIsIntegral checks that the value is a finite whole number
+func floatIsIntegral[T ~float64](v T) error {
+	f := float64(v)
+	if math.IsInf(f, 0) || math.IsNaN(f) || f != math.Trunc(f) {
+		return fmt.Errorf("the value (%v) should be a whole number", v)
+	}
+
+	return nil
+}
+
+// checkTolerance panics if the tolerance is negative or NaN
+func checkTolerance(name string, tol float64) {
+	if !(tol >= 0) {
+		panic(fmt.Sprintf("the %s (%v) must not be negative or NaN",
+			name, tol))
+	}
+}
+
+// floatApproxEQ returns a function that checks that the value differs from
+// the target by no more than the tolerance. It panics if the tolerance is
+// negative or NaN.
+func floatApproxEQ[T ~float64](target, tol float64) check.ValCk[T] {
+	checkTolerance("tolerance", tol)
+
+	return func(v T) error {
+		if float64(v) == target || math.Abs(float64(v)-target) <= tol {
+			return nil
+		}
+
+		return fmt.Errorf("the value (%v) should be within %v of %v",
+			v, tol, target)
+	}
+}
+
+// floatRelApproxEQ returns a function that checks that the value differs
+// from the target by no more than the relative tolerance times the larger
+// of the magnitudes of the value and the target. It panics if the relative
+// tolerance is negative or NaN.
+func floatRelApproxEQ[T ~float64](target, relTol float64) check.ValCk[T] {
+	checkTolerance("relative tolerance", relTol)
+
+	return func(v T) error {
+		f := float64(v)
+		if f == target ||
+			math.Abs(f-target) <= relTol*max(math.Abs(f), math.Abs(target)) {
+			return nil
+		}
+
+		return fmt.Errorf("the value (%v) should be within a relative"+
+			" tolerance of %v of %v",
+			v, relTol, target)
+	}
+}
+
+// floatSign returns a function that checks that the sign of the value (-1,
+// 0 or 1) passes the supplied check. A NaN value has no sign and so always
+// fails.
+func floatSign[T ~float64](cf check.ValCk[int]) check.ValCk[T] {
+	return func(v T) error {
+		f := float64(v)
+
+		var sign int
+
+		switch {
+		case math.IsNaN(f):
+			return errors.New("the value is NaN and so has no sign")
+		case f > 0:
+			sign = 1
+		case f < 0:
+			sign = -1
+		}
+
+		if err := cf(sign); err != nil {
+			return fmt.Errorf("the sign of the value (%v) is incorrect: %w",
+				v, err)
+		}
+
+		return nil
+	}
+}
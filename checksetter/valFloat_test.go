@@ -0,0 +1,131 @@
+package checksetter_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestFloatChecks(t *testing.T) {
+	p := checksetter.FindParserOrPanic[float64](
+		checksetter.Float64CheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[float64]
+	}{
+		{
+			ID:   testhelper.MkID("IsFinite"),
+			expr: "IsFinite",
+			valErrs: []valErr[float64]{
+				{val: 1.5},
+				{val: math.Inf(-1), expErr: "the value (-Inf) should be finite"},
+				{val: math.NaN(), expErr: "the value (NaN) should be finite"},
+			},
+		},
+		{
+			ID:   testhelper.MkID("NotNaN"),
+			expr: "NotNaN",
+			valErrs: []valErr[float64]{
+				{val: math.Inf(1)},
+				{val: math.NaN(), expErr: "the value should not be NaN"},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsIntegral"),
+			expr: "IsIntegral",
+			valErrs: []valErr[float64]{
+				{val: -3},
+				{val: 1e20},
+				{val: 2.5, expErr: "the value (2.5) should be a whole number"},
+				{
+					val:    math.Inf(1),
+					expErr: "the value (+Inf) should be a whole number",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("ApproxEQ"),
+			expr: "ApproxEQ(1, 0.125)",
+			valErrs: []valErr[float64]{
+				{val: 1.125},
+				{val: 0.875},
+				{
+					val:    1.25,
+					expErr: "the value (1.25) should be within 0.125 of 1",
+				},
+				{
+					val:    math.NaN(),
+					expErr: "the value (NaN) should be within 0.125 of 1",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("ApproxEQ: Inf"),
+			expr: "ApproxEQ(-Inf, 0)",
+			valErrs: []valErr[float64]{
+				{val: math.Inf(-1)},
+				{
+					val:    math.Inf(1),
+					expErr: "the value (+Inf) should be within 0 of -Inf",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("RelApproxEQ"),
+			expr: "RelApproxEQ(100, 0.01)",
+			valErrs: []valErr[float64]{
+				{val: 101},
+				{val: 99},
+				{
+					val: 102,
+					expErr: "the value (102) should be within" +
+						" a relative tolerance of 0.01 of 100",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Sign"),
+			expr: "Sign(GE(0))",
+			valErrs: []valErr[float64]{
+				{val: 0},
+				{val: math.Inf(1)},
+				{
+					val: -0.5,
+					expErr: "the sign of the value (-0.5) is incorrect:" +
+						" the value (-1) must be greater than or equal to 0",
+				},
+				{val: math.NaN(), expErr: "the value is NaN and so has no sign"},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Inf and signed literals"),
+			expr: "Between(-1.5, +Inf)",
+			valErrs: []valErr[float64]{
+				{val: -1.5},
+				{val: math.Inf(1)},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: negative tolerance"),
+			ExpErr: testhelper.MkExpErr(
+				"ApproxEQ(float64, float64):",
+				"the tolerance (-1) must not be negative or NaN"),
+			expr: "ApproxEQ(1, -1)",
+		},
+		{
+			ID: testhelper.MkID("bad: unary operator"),
+			ExpErr: testhelper.MkExpErr(
+				"GT(float64): bad unary operator (!), only '+' or '-'"),
+			expr: "GT(!Inf)",
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+}
@@ -0,0 +1,231 @@
+package checksetter
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// The limits on the lengths of a hostname and of each of its labels
+const (
+	maxHostnameLen = 253
+	maxLabelLen    = 63
+)
+
+// stringIsIP checks that the string is an IPv4 or IPv6 address
+func stringIsIP[T ~string](v T) error {
+	if _, err := netip.ParseAddr(string(v)); err != nil {
+		return fmt.Errorf("%q should be an IP address", v)
+	}
+
+	return nil
+}
+
+// stringIsIPv4 checks that the string is an IPv4 address
+func stringIsIPv4[T ~string](v T) error {
+	addr, err := netip.ParseAddr(string(v))
+	if err != nil || !addr.Is4() {
+		return fmt.Errorf("%q should be an IPv4 address", v)
+	}
+
+	return nil
+}
+
+// stringIsIPv6 checks that the string is an IPv6 address
+func stringIsIPv6[T ~string](v T) error {
+	addr, err := netip.ParseAddr(string(v))
+	if err != nil || !addr.Is6() {
+		return fmt.Errorf("%q should be an IPv6 address", v)
+	}
+
+	return nil
+}
+
+// stringInCIDR returns a function that checks that the string is an IP
+// address in the given network
+func stringInCIDR[T ~string](network netip.Prefix) check.ValCk[T] {
+	return func(v T) error {
+		addr, err := netip.ParseAddr(string(v))
+		if err != nil {
+			return fmt.Errorf("%q should be an IP address in %s", v, network)
+		}
+
+		if !network.Contains(addr) {
+			return fmt.Errorf("%q should be in %s", v, network)
+		}
+
+		return nil
+	}
+}
+
+// hostnameErr returns a non-nil error describing the problem if the name is
+// not a valid hostname (as described in RFC 1123)
+func hostnameErr(name string) error {
+	if name == "" {
+		return errors.New("it is empty")
+	}
+
+	if len(name) > maxHostnameLen {
+		return fmt.Errorf("it is longer than %d characters", maxHostnameLen)
+	}
+
+	for _, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		switch {
+		case label == "":
+			return errors.New("it has an empty label")
+		case len(label) > maxLabelLen:
+			return fmt.Errorf("the label %q is longer than %d characters",
+				label, maxLabelLen)
+		case strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-"):
+			return fmt.Errorf("the label %q starts or ends with a '-'", label)
+		}
+
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' ||
+				r >= '0' && r <= '9' || r == '-') {
+				return fmt.Errorf("the label %q has a bad character: %q",
+					label, r)
+			}
+		}
+	}
+
+	return nil
+}
+
+// stringIsHostname checks that the string is a valid hostname
+func stringIsHostname[T ~string](v T) error {
+	if err := hostnameErr(string(v)); err != nil {
+		return fmt.Errorf("%q should be a valid hostname: %w", v, err)
+	}
+
+	return nil
+}
+
+// splitHostPort splits the string into the host and the port. It returns
+// a non-nil error if the string is not of the form host:port or the port
+// is not a number.
+func splitHostPort(s string) (string, int, error) {
+	host, portStr, err := net.SplitHostPort(s)
+	if err != nil {
+		return "", 0, fmt.Errorf("%q should be of the form host:port: %w",
+			s, err)
+	}
+
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("%q should be of the form host:port:"+
+			" the port (%q) should be a number between 0 and 65535",
+			s, portStr)
+	}
+
+	return host, int(port), nil
+}
+
+// stringIsHostPort checks that the string is of the form host:port where
+// the host is a hostname or an IP address
+func stringIsHostPort[T ~string](v T) error {
+	host, _, err := splitHostPort(string(v))
+	if err != nil {
+		return err
+	}
+
+	if _, err := netip.ParseAddr(host); err == nil {
+		return nil
+	}
+
+	if err := hostnameErr(host); err != nil {
+		return fmt.Errorf("%q should be of the form host:port:"+
+			" the host (%q) should be an IP address or a valid hostname: %w",
+			v, host, err)
+	}
+
+	return nil
+}
+
+// stringPort returns a function that checks that the string is of the
+// form host:port and that the port passes the supplied check
+func stringPort[T ~string](cf check.ValCk[int]) check.ValCk[T] {
+	return func(v T) error {
+		_, port, err := splitHostPort(string(v))
+		if err != nil {
+			return err
+		}
+
+		if err := cf(port); err != nil {
+			return fmt.Errorf("the port of %q (%d) is incorrect: %w",
+				v, port, err)
+		}
+
+		return nil
+	}
+}
+
+// parseURL parses the string as an absolute URL with a host
+func parseURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%q should be a URL: %w", s, err)
+	}
+
+	if u.Scheme == "" || u.Host == "" {
+		return nil,
+			fmt.Errorf("%q should be a URL with a scheme and a host", s)
+	}
+
+	return u, nil
+}
+
+// stringIsURL returns a function that checks that the string is an
+// absolute URL. If any schemes are given the URL must have one of them.
+func stringIsURL[T ~string](schemes ...string) check.ValCk[T] {
+	return func(v T) error {
+		u, err := parseURL(string(v))
+		if err != nil {
+			return err
+		}
+
+		if len(schemes) > 0 && !slices.Contains(schemes, u.Scheme) {
+			return fmt.Errorf("%q should have a scheme of %s",
+				v, strings.Join(schemes, " or "))
+		}
+
+		return nil
+	}
+}
+
+// stringURLHost returns a function that checks that the string is an
+// absolute URL and that its host (without any port) passes the supplied
+// check
+func stringURLHost[T ~string](cf check.ValCk[string]) check.ValCk[T] {
+	return func(v T) error {
+		u, err := parseURL(string(v))
+		if err != nil {
+			return err
+		}
+
+		if err := cf(u.Hostname()); err != nil {
+			return fmt.Errorf("the host of %q is incorrect: %w", v, err)
+		}
+
+		return nil
+	}
+}
+
+// stringIsEmail checks that the string is an email address, without any
+// display name or angle brackets
+func stringIsEmail[T ~string](v T) error {
+	addr, err := mail.ParseAddress(string(v))
+	if err != nil || addr.Address != string(v) {
+		return fmt.Errorf("%q should be an email address", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestNetChecks(t *testing.T) {
+	p := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("IsIP"),
+			expr: "IsIP",
+			valErrs: []valErr[string]{
+				{val: "10.1.2.3"},
+				{val: "::1"},
+				{val: "10.1.2", expErr: `"10.1.2" should be an IP address`},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsIPv4"),
+			expr: "IsIPv4",
+			valErrs: []valErr[string]{
+				{val: "10.1.2.3"},
+				{val: "::1", expErr: `"::1" should be an IPv4 address`},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsIPv6"),
+			expr: "IsIPv6",
+			valErrs: []valErr[string]{
+				{val: "fe80::1"},
+				{
+					val:    "10.1.2.3",
+					expErr: `"10.1.2.3" should be an IPv6 address`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("InCIDR"),
+			expr: `InCIDR("10.0.0.0/8")`,
+			valErrs: []valErr[string]{
+				{val: "10.255.0.1"},
+				{val: "11.0.0.1", expErr: `"11.0.0.1" should be in 10.0.0.0/8`},
+				{
+					val:    "host",
+					expErr: `"host" should be an IP address in 10.0.0.0/8`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsHostname"),
+			expr: "IsHostname",
+			valErrs: []valErr[string]{
+				{val: "example.com"},
+				{val: "a-1.example.com."},
+				{
+					val: "-a.example.com",
+					expErr: `"-a.example.com" should be a valid hostname:` +
+						` the label "-a" starts or ends with a '-'`,
+				},
+				{
+					val: "a..b",
+					expErr: `"a..b" should be a valid hostname:` +
+						` it has an empty label`,
+				},
+				{
+					val: "a_b",
+					expErr: `"a_b" should be a valid hostname:` +
+						` the label "a_b" has a bad character: '_'`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsHostPort"),
+			expr: "IsHostPort",
+			valErrs: []valErr[string]{
+				{val: "example.com:80"},
+				{val: "[::1]:8080"},
+				{
+					val: "example.com",
+					expErr: `"example.com" should be of the form host:port:` +
+						" address example.com: missing port in address",
+				},
+				{
+					val: "example.com:http",
+					expErr: `"example.com:http" should be of the form` +
+						` host:port: the port ("http") should be a number` +
+						" between 0 and 65535",
+				},
+				{
+					val: "a_b:80",
+					expErr: `"a_b:80" should be of the form host:port:` +
+						` the host ("a_b") should be an IP address or a` +
+						` valid hostname: the label "a_b" has a bad` +
+						` character: '_'`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Port"),
+			expr: "Port(GE(1024))",
+			valErrs: []valErr[string]{
+				{val: "localhost:8080"},
+				{
+					val: "localhost:80",
+					expErr: `the port of "localhost:80" (80) is incorrect:` +
+						" the value (80) must be greater than or equal to 1024",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsURL, no schemes"),
+			expr: "IsURL",
+			valErrs: []valErr[string]{
+				{val: "ftp://example.com/x"},
+				{
+					val:    "example.com/x",
+					expErr: `"example.com/x" should be a URL with a scheme and a host`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsURL, schemes"),
+			expr: `IsURL("https", "http")`,
+			valErrs: []valErr[string]{
+				{val: "https://example.com/x"},
+				{
+					val:    "ftp://example.com/x",
+					expErr: `"ftp://example.com/x" should have a scheme of https or http`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("URLHost"),
+			expr: `URLHost(HasSuffix(".example.com"))`,
+			valErrs: []valErr[string]{
+				{val: "https://www.example.com:8443/x"},
+				{
+					val: "https://example.org/x",
+					expErr: `the host of "https://example.org/x" is incorrect:` +
+						` "example.org" should have ".example.com" as a suffix`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsEmail"),
+			expr: "IsEmail",
+			valErrs: []valErr[string]{
+				{val: "someone@example.com"},
+				{
+					val:    "Someone <someone@example.com>",
+					expErr: `"Someone <someone@example.com>" should be an email address`,
+				},
+				{val: "someone", expErr: `"someone" should be an email address`},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: InCIDR, bad CIDR"),
+			ExpErr: testhelper.MkExpErr("InCIDR(cidr):",
+				"bad CIDR:"),
+			expr: `InCIDR("10.0.0.0")`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+}
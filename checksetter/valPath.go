@@ -0,0 +1,112 @@
+package checksetter
+
+import (
+	"fmt"
+	"io/fs"
+	"time"
+
+	"github.com/nickwells/check.mod/v2/check"
+	"github.com/nickwells/filecheck.mod/filecheck"
+)
+
+// pathExists checks that the file-system object exists
+func pathExists(name string) error {
+	return filecheck.Provisos{Existence: filecheck.MustExist}.StatusCheck(name)
+}
+
+// pathNotExists checks that the file-system object does not exist
+func pathNotExists(name string) error {
+	return filecheck.IsNew().StatusCheck(name)
+}
+
+// pathIsDir checks that the file-system object exists and is a directory
+func pathIsDir(name string) error {
+	return filecheck.DirExists().StatusCheck(name)
+}
+
+// pathIsRegular checks that the file-system object exists and is a regular
+// file
+func pathIsRegular(name string) error {
+	return filecheck.FileExists().StatusCheck(name)
+}
+
+// pathIsSymlink checks that the file-system object exists and is a
+// symbolic link
+func pathIsSymlink(name string) error {
+	return filecheck.Provisos{
+		Existence:          filecheck.MustExist,
+		Checks:             []check.FileInfo{check.FileInfoMode(fs.ModeSymlink)},
+		DontFollowSymlinks: true,
+	}.StatusCheck(name)
+}
+
+// pathReadable checks that the file-system object exists and can be read
+func pathReadable(name string) error {
+	if err := pathExists(name); err != nil {
+		return err
+	}
+
+	if err := pathAccessible(name, false); err != nil {
+		return fmt.Errorf("path: %q: should be readable but is not: %w",
+			name, err)
+	}
+
+	return nil
+}
+
+// pathWritable checks that the file-system object exists and can be
+// written
+func pathWritable(name string) error {
+	if err := pathExists(name); err != nil {
+		return err
+	}
+
+	if err := pathAccessible(name, true); err != nil {
+		return fmt.Errorf("path: %q: should be writable but is not: %w",
+			name, err)
+	}
+
+	return nil
+}
+
+// pathHasPerm returns a function that checks that the file-system object
+// exists and has exactly the given permissions
+func pathHasPerm(perm fs.FileMode) check.ValCk[string] {
+	return filecheck.Provisos{
+		Existence: filecheck.MustExist,
+		Checks: []check.FileInfo{
+			check.FileInfoPerm(check.FilePermEQ(perm)),
+		},
+	}.StatusCheck
+}
+
+// pathSizeLE returns a function that checks that the file-system object
+// exists and its size is no greater than the given number of bytes
+func pathSizeLE(size int64) check.ValCk[string] {
+	return filecheck.Provisos{
+		Existence: filecheck.MustExist,
+		Checks: []check.FileInfo{
+			check.FileInfoSize(check.ValLE(size)),
+		},
+	}.StatusCheck
+}
+
+// pathModifiedWithin returns a function that checks that the file-system
+// object exists and was last modified no longer ago than the given
+// duration
+func pathModifiedWithin(d time.Duration) check.ValCk[string] {
+	return filecheck.Provisos{
+		Existence: filecheck.MustExist,
+		Checks: []check.FileInfo{
+			check.FileInfoModTime(func(t time.Time) error {
+				if age := time.Since(t); age > d {
+					return fmt.Errorf("it was modified %s ago,"+
+						" it should have been modified within %s",
+						age.Round(time.Second), d)
+				}
+
+				return nil
+			}),
+		},
+	}.StatusCheck
+}
@@ -0,0 +1,197 @@
+package checksetter_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// mkPathTestDir creates a directory holding the file-system objects used to
+// test the path-checker. It is a fatal error if they cannot be created.
+func mkPathTestDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	file := filepath.Join(dir, "file")
+	if err := os.WriteFile(file, []byte("0123456789"), 0o600); err != nil {
+		t.Fatal("couldn't create the test file: " + err.Error())
+	}
+
+	old := filepath.Join(dir, "old")
+	if err := os.WriteFile(old, []byte{}, 0o644); err != nil { //nolint:gosec
+		t.Fatal("couldn't create the old test file: " + err.Error())
+	}
+
+	oldTime := time.Now().Add(-48 * time.Hour)
+	if err := os.Chtimes(old, oldTime, oldTime); err != nil {
+		t.Fatal("couldn't set the time of the old test file: " + err.Error())
+	}
+
+	if err := os.Symlink(file, filepath.Join(dir, "link")); err != nil {
+		t.Fatal("couldn't create the test symlink: " + err.Error())
+	}
+
+	return dir
+}
+
+func TestPathChecker(t *testing.T) {
+	dir := mkPathTestDir(t)
+	p := checksetter.FindParserOrPanic[string](checksetter.PathCheckerName)
+
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("Exists"),
+			expr: "Exists",
+			valErrs: []valErr[string]{
+				{val: path("file")},
+				{val: dir},
+				{
+					val: path("nonesuch"),
+					expErr: "path: " + strconv.Quote(path("nonesuch")) +
+						": should exist but does not",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("NotExists"),
+			expr: "NotExists",
+			valErrs: []valErr[string]{
+				{val: path("nonesuch")},
+				{
+					val: path("file"),
+					expErr: "path: " + strconv.Quote(path("file")) +
+						": should not exist but does",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsDir"),
+			expr: "IsDir",
+			valErrs: []valErr[string]{
+				{val: dir},
+				{val: path("file"), expErr: `"file" should be a directory`},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsRegular"),
+			expr: "IsRegular",
+			valErrs: []valErr[string]{
+				{val: path("file")},
+				{val: path("link")},
+				{
+					val: dir,
+					expErr: strconv.Quote(filepath.Base(dir)) +
+						" should be a regular file",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsSymlink"),
+			expr: "IsSymlink",
+			valErrs: []valErr[string]{
+				{val: path("link")},
+				{
+					val: path("file"),
+					expErr: `"file" should have been a symlink` +
+						" but was a regular file",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("HasPerm"),
+			expr: `HasPerm("0600")`,
+			valErrs: []valErr[string]{
+				{val: path("file")},
+				{
+					val: path("old"),
+					expErr: `the file permissions of "old" are incorrect:` +
+						" the permissions (0644) should equal 0600",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SizeLE"),
+			expr: "SizeLE(10)",
+			valErrs: []valErr[string]{
+				{val: path("file")},
+				{val: path("old")},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SizeLE, too big"),
+			expr: "SizeLE(9)",
+			valErrs: []valErr[string]{
+				{
+					val: path("file"),
+					expErr: `the check on the size of "file" failed:` +
+						" the value (10) must be less than or equal to 9",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("ModifiedWithin"),
+			expr: `ModifiedWithin("24h")`,
+			valErrs: []valErr[string]{
+				{val: path("file")},
+				{
+					val: path("old"),
+					expErr: `the modification time of "old" is incorrect:` +
+						" it was modified 48h0m0s ago," +
+						" it should have been modified within 24h0m0s",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("Readable, Writable"),
+			expr: "And(Readable, Writable)",
+			valErrs: []valErr[string]{
+				{val: path("file")},
+				{val: dir},
+				{
+					val: path("nonesuch"),
+					expErr: "path: " + strconv.Quote(path("nonesuch")) +
+						": should exist but does not",
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: HasPerm, bad permissions"),
+			ExpErr: testhelper.MkExpErr("HasPerm(perm):",
+				`bad permissions: "0999" should be an octal number`),
+			expr: `HasPerm("0999")`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+
+	sp := checksetter.FindParserOrPanic[[]string](
+		checksetter.StringSliceCheckerName)
+
+	vcs, err := sp.Parse("SliceAll(AsPath(IsRegular))")
+	if err != nil {
+		t.Fatal("unexpected error: " + err.Error())
+	}
+
+	if err := vcs[0]([]string{filepath.Join(dir, "file")}); err != nil {
+		t.Error("unexpected error checking a slice of paths: " + err.Error())
+	}
+
+	if err := vcs[0]([]string{dir}); err == nil {
+		t.Error("missing error checking a slice holding a directory")
+	}
+}
@@ -0,0 +1,259 @@
+package checksetter
+
+import (
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// ordVal is a value from the ordered domain of a family of checks. It
+// holds either a number or, if the number is nil, a string.
+type ordVal struct {
+	num *big.Rat
+	str string
+}
+
+// cmp returns -1, 0 or 1 as the value is less than, equal to or greater
+// than the other
+func (v ordVal) cmp(other ordVal) int {
+	if v.num != nil {
+		return v.num.Cmp(other.num)
+	}
+
+	return strings.Compare(v.str, other.str)
+}
+
+// add returns the value with n added to it. It must only be called for a
+// numeric value.
+func (v ordVal) add(n int64) ordVal {
+	return ordVal{num: new(big.Rat).Add(v.num, big.NewRat(n, 1))}
+}
+
+// endpoint is one end of an interval. If unbounded is set the interval
+// extends indefinitely in that direction and the other fields are unused.
+type endpoint struct {
+	val       ordVal
+	unbounded bool
+	open      bool
+}
+
+// interval is a contiguous range of values from lo to hi
+type interval struct {
+	lo, hi endpoint
+}
+
+// valRange is a set of values made up of disjoint intervals, in increasing
+// order. If integral is set only whole numbers are in the domain and every
+// bounded endpoint is closed.
+type valRange struct {
+	integral bool
+	ivs      []interval
+}
+
+// allVals returns the range holding every value in the domain
+func allVals(integral bool) valRange {
+	return valRange{
+		integral: integral,
+		ivs: []interval{{
+			lo: endpoint{unbounded: true},
+			hi: endpoint{unbounded: true},
+		}},
+	}
+}
+
+// noVals returns the empty range
+func noVals(integral bool) valRange {
+	return valRange{integral: integral}
+}
+
+// mkRange returns the range holding the values between the endpoints
+func mkRange(integral bool, lo, hi endpoint) valRange {
+	r := noVals(integral)
+	if iv, ok := r.normalise(interval{lo: lo, hi: hi}); ok {
+		r.ivs = append(r.ivs, iv)
+	}
+
+	return r
+}
+
+// normalise closes any open bounded endpoints if the domain is integral. It
+// returns false if the interval is empty.
+func (r valRange) normalise(iv interval) (interval, bool) {
+	if r.integral {
+		if !iv.lo.unbounded && iv.lo.open {
+			iv.lo = endpoint{val: iv.lo.val.add(1)}
+		}
+
+		if !iv.hi.unbounded && iv.hi.open {
+			iv.hi = endpoint{val: iv.hi.val.add(-1)}
+		}
+	}
+
+	if iv.lo.unbounded || iv.hi.unbounded {
+		return iv, true
+	}
+
+	c := iv.lo.val.cmp(iv.hi.val)
+
+	return iv, c < 0 || (c == 0 && !iv.lo.open && !iv.hi.open)
+}
+
+// isEmpty returns true if there are no values in the range
+func (r valRange) isEmpty() bool {
+	return len(r.ivs) == 0
+}
+
+// cmpLo compares two lower endpoints, returning a negative number if a
+// admits lower values than b
+func cmpLo(a, b endpoint) int {
+	switch {
+	case a.unbounded && b.unbounded:
+		return 0
+	case a.unbounded:
+		return -1
+	case b.unbounded:
+		return 1
+	}
+
+	if c := a.val.cmp(b.val); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.open == b.open:
+		return 0
+	case a.open:
+		return 1
+	}
+
+	return -1
+}
+
+// cmpHi compares two upper endpoints, returning a positive number if a
+// admits higher values than b
+func cmpHi(a, b endpoint) int {
+	switch {
+	case a.unbounded && b.unbounded:
+		return 0
+	case a.unbounded:
+		return 1
+	case b.unbounded:
+		return -1
+	}
+
+	if c := a.val.cmp(b.val); c != 0 {
+		return c
+	}
+
+	switch {
+	case a.open == b.open:
+		return 0
+	case a.open:
+		return -1
+	}
+
+	return 1
+}
+
+// joins returns true if an interval ending at hi and one starting at lo
+// (which is no lower than the start of the first) overlap or are adjacent
+func (r valRange) joins(hi, lo endpoint) bool {
+	if hi.unbounded || lo.unbounded {
+		return true
+	}
+
+	c := lo.val.cmp(hi.val)
+
+	switch {
+	case c < 0:
+		return true
+	case c == 0:
+		return !lo.open || !hi.open
+	}
+
+	return r.integral && lo.val.cmp(hi.val.add(1)) == 0
+}
+
+// union returns the range holding the values in either range
+func (r valRange) union(other valRange) valRange {
+	all := slices.Concat(r.ivs, other.ivs)
+	slices.SortFunc(all, func(a, b interval) int { return cmpLo(a.lo, b.lo) })
+
+	u := noVals(r.integral)
+
+	for _, iv := range all {
+		if n := len(u.ivs); n > 0 && r.joins(u.ivs[n-1].hi, iv.lo) {
+			if cmpHi(iv.hi, u.ivs[n-1].hi) > 0 {
+				u.ivs[n-1].hi = iv.hi
+			}
+
+			continue
+		}
+
+		u.ivs = append(u.ivs, iv)
+	}
+
+	return u
+}
+
+// intersect returns the range holding the values in both ranges
+func (r valRange) intersect(other valRange) valRange {
+	var parts valRange
+
+	parts.integral = r.integral
+
+	for _, a := range r.ivs {
+		for _, b := range other.ivs {
+			iv := a
+			if cmpLo(b.lo, iv.lo) > 0 {
+				iv.lo = b.lo
+			}
+
+			if cmpHi(b.hi, iv.hi) < 0 {
+				iv.hi = b.hi
+			}
+
+			if iv, ok := r.normalise(iv); ok {
+				parts.ivs = append(parts.ivs, iv)
+			}
+		}
+	}
+
+	return noVals(r.integral).union(parts)
+}
+
+// complement returns the range holding the values not in the range
+func (r valRange) complement() valRange {
+	c := noVals(r.integral)
+	lo := endpoint{unbounded: true}
+
+	for _, iv := range r.ivs {
+		if !iv.lo.unbounded {
+			gap := interval{
+				lo: lo,
+				hi: endpoint{val: iv.lo.val, open: !iv.lo.open},
+			}
+			if gap, ok := r.normalise(gap); ok {
+				c.ivs = append(c.ivs, gap)
+			}
+		}
+
+		if iv.hi.unbounded {
+			return c
+		}
+
+		lo = endpoint{val: iv.hi.val, open: !iv.hi.open}
+	}
+
+	if iv, ok := r.normalise(
+		interval{lo: lo, hi: endpoint{unbounded: true}}); ok {
+		c.ivs = append(c.ivs, iv)
+	}
+
+	return c
+}
+
+// isSubsetOf returns true if every value in the range is in the other range
+func (r valRange) isSubsetOf(other valRange) bool {
+	return r.intersect(other.complement()).isEmpty()
+}
@@ -0,0 +1,282 @@
+package checksetter
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// semver holds the parts of a semantic version (see https://semver.org)
+type semver struct {
+	major, minor, patch int
+	prerelease          []string
+}
+
+// isSemverIdent returns true if the string is a non-empty sequence of ASCII
+// alphanumerics and hyphens
+func isSemverIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' ||
+			r >= 'A' && r <= 'Z' || r == '-') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNumeric returns true if the string is a non-empty sequence of digits
+func isNumeric(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// parseSemverNum parses a numeric part of a semantic version. Leading zeros
+// are not allowed.
+func parseSemverNum(name, s string) (int, error) {
+	if !isNumeric(s) {
+		return 0, fmt.Errorf("the %s version (%q) should be a number", name, s)
+	}
+
+	if len(s) > 1 && s[0] == '0' {
+		return 0, fmt.Errorf("the %s version (%q) has a leading zero", name, s)
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("the %s version (%q) is too big", name, s)
+	}
+
+	return n, nil
+}
+
+// parseSemver parses the string as a semantic version, following the rules
+// of version 2.0.0 of the specification
+func parseSemver(s string) (semver, error) {
+	var sv semver
+
+	s, build, hasBuild := strings.Cut(s, "+")
+	if hasBuild {
+		for _, id := range strings.Split(build, ".") {
+			if !isSemverIdent(id) {
+				return sv, fmt.Errorf("bad build metadata: %q", build)
+			}
+		}
+	}
+
+	s, pre, hasPre := strings.Cut(s, "-")
+	if hasPre {
+		sv.prerelease = strings.Split(pre, ".")
+		for _, id := range sv.prerelease {
+			if !isSemverIdent(id) ||
+				(isNumeric(id) && len(id) > 1 && id[0] == '0') {
+				return sv, fmt.Errorf("bad pre-release version: %q", pre)
+			}
+		}
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 { //nolint:mnd
+		return sv, errors.New("it should have the form MAJOR.MINOR.PATCH")
+	}
+
+	var err error
+
+	for i, p := range []*int{&sv.major, &sv.minor, &sv.patch} {
+		*p, err = parseSemverNum([]string{"major", "minor", "patch"}[i],
+			parts[i])
+		if err != nil {
+			return sv, err
+		}
+	}
+
+	return sv, nil
+}
+
+// compareSemverIdents compares two pre-release identifiers using the
+// semantic version precedence rules
+func compareSemverIdents(a, b string) int {
+	aNum, bNum := isNumeric(a), isNumeric(b)
+
+	switch {
+	case aNum && bNum:
+		return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+
+	return strings.Compare(a, b)
+}
+
+// compare returns -1, 0 or 1 as the semantic version has lower, equal or
+// higher precedence than the other. Build metadata is ignored.
+func (sv semver) compare(other semver) int {
+	if c := cmp.Or(
+		cmp.Compare(sv.major, other.major),
+		cmp.Compare(sv.minor, other.minor),
+		cmp.Compare(sv.patch, other.patch)); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(sv.prerelease) == 0 && len(other.prerelease) == 0:
+		return 0
+	case len(sv.prerelease) == 0:
+		return 1
+	case len(other.prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(sv.prerelease) && i < len(other.prerelease); i++ {
+		if c := compareSemverIdents(sv.prerelease[i], other.prerelease[i]); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(sv.prerelease), len(other.prerelease))
+}
+
+// getSemver parses the string as a semantic version, returning an error
+// which describes the problem if it cannot be parsed
+func getSemver[T ~string](v T) (semver, error) {
+	sv, err := parseSemver(string(v))
+	if err != nil {
+		return sv, fmt.Errorf("%q should be a semantic version: %w", v, err)
+	}
+
+	return sv, nil
+}
+
+// stringIsSemver checks that the string is a semantic version
+func stringIsSemver[T ~string](v T) error {
+	_, err := getSemver(v)
+
+	return err
+}
+
+// stringSemverNoPrerelease checks that the string is a semantic version
+// without a pre-release part
+func stringSemverNoPrerelease[T ~string](v T) error {
+	sv, err := getSemver(v)
+	if err != nil {
+		return err
+	}
+
+	if len(sv.prerelease) > 0 {
+		return fmt.Errorf("%q should not be a pre-release version", v)
+	}
+
+	return nil
+}
+
+// stringSemverCmp returns a function that checks that the string is a
+// semantic version which compares with the given version as required by the
+// test func. The description is used in any error message.
+func stringSemverCmp[T ~string](
+	version, desc string, test func(c int) bool,
+) check.ValCk[T] {
+	limit, err := parseSemver(version)
+	if err != nil {
+		panic(fmt.Sprintf("bad semantic version: %q: %s", version, err))
+	}
+
+	return func(v T) error {
+		sv, err := getSemver(v)
+		if err != nil {
+			return err
+		}
+
+		if !test(sv.compare(limit)) {
+			return fmt.Errorf("%q should be a semantic version %s %s",
+				v, desc, version)
+		}
+
+		return nil
+	}
+}
+
+// stringSemverEQ returns a function that checks that the string is a
+// semantic version with the same precedence as the given version
+func stringSemverEQ[T ~string](version string) check.ValCk[T] {
+	return stringSemverCmp[T](version, "equal to",
+		func(c int) bool { return c == 0 })
+}
+
+// stringSemverGT returns a function that checks that the string is a
+// semantic version with higher precedence than the given version
+func stringSemverGT[T ~string](version string) check.ValCk[T] {
+	return stringSemverCmp[T](version, "greater than",
+		func(c int) bool { return c > 0 })
+}
+
+// stringSemverGE returns a function that checks that the string is a
+// semantic version with precedence no lower than the given version
+func stringSemverGE[T ~string](version string) check.ValCk[T] {
+	return stringSemverCmp[T](version, "greater than or equal to",
+		func(c int) bool { return c >= 0 })
+}
+
+// stringSemverLT returns a function that checks that the string is a
+// semantic version with lower precedence than the given version
+func stringSemverLT[T ~string](version string) check.ValCk[T] {
+	return stringSemverCmp[T](version, "less than",
+		func(c int) bool { return c < 0 })
+}
+
+// stringSemverLE returns a function that checks that the string is a
+// semantic version with precedence no higher than the given version
+func stringSemverLE[T ~string](version string) check.ValCk[T] {
+	return stringSemverCmp[T](version, "less than or equal to",
+		func(c int) bool { return c <= 0 })
+}
+
+// stringSemverPart returns a function that checks that the string is a
+// semantic version and that the part returned by the get func passes the
+// supplied check. The name is used in any error message.
+func stringSemverPart[T ~string](
+	name string, get func(semver) int, cf check.ValCk[int],
+) check.ValCk[T] {
+	return func(v T) error {
+		sv, err := getSemver(v)
+		if err != nil {
+			return err
+		}
+
+		if err := cf(get(sv)); err != nil {
+			return fmt.Errorf("the %s version of %q is incorrect: %w",
+				name, v, err)
+		}
+
+		return nil
+	}
+}
+
+// stringSemverMajor returns a function that checks that the string is a
+// semantic version whose major version passes the supplied check
+func stringSemverMajor[T ~string](cf check.ValCk[int]) check.ValCk[T] {
+	return stringSemverPart[T]("major",
+		func(sv semver) int { return sv.major }, cf)
+}
+
+// stringSemverMinor returns a function that checks that the string is a
+// semantic version whose minor version passes the supplied check
+func stringSemverMinor[T ~string](cf check.ValCk[int]) check.ValCk[T] {
+	return stringSemverPart[T]("minor",
+		func(sv semver) int { return sv.minor }, cf)
+}
+
+// stringSemverPatch returns a function that checks that the string is a
+// semantic version whose patch version passes the supplied check
+func stringSemverPatch[T ~string](cf check.ValCk[int]) check.ValCk[T] {
+	return stringSemverPart[T]("patch",
+		func(sv semver) int { return sv.patch }, cf)
+}
@@ -0,0 +1,153 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestSemverChecks(t *testing.T) {
+	p := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("IsSemver"),
+			expr: "IsSemver",
+			valErrs: []valErr[string]{
+				{val: "1.2.3"},
+				{val: "1.0.0-alpha.1+build.5"},
+				{val: "0.0.0+20130313144700"},
+				{
+					val: "1.2",
+					expErr: `"1.2" should be a semantic version:` +
+						` it should have the form MAJOR.MINOR.PATCH`,
+				},
+				{
+					val: "v1.2.3",
+					expErr: `"v1.2.3" should be a semantic version:` +
+						` the major version ("v1") should be a number`,
+				},
+				{
+					val: "01.2.3",
+					expErr: `"01.2.3" should be a semantic version:` +
+						` the major version ("01") has a leading zero`,
+				},
+				{
+					val: "1.2.3-01",
+					expErr: `"1.2.3-01" should be a semantic version:` +
+						` bad pre-release version: "01"`,
+				},
+				{
+					val: "1.2.3+a..b",
+					expErr: `"1.2.3+a..b" should be a semantic version:` +
+						` bad build metadata: "a..b"`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverNoPrerelease"),
+			expr: "SemverNoPrerelease",
+			valErrs: []valErr[string]{
+				{val: "1.2.3+build"},
+				{
+					val:    "1.2.3-rc.1",
+					expErr: `"1.2.3-rc.1" should not be a pre-release version`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverGE"),
+			expr: `SemverGE("1.4.0")`,
+			valErrs: []valErr[string]{
+				{val: "1.4.0"},
+				{val: "1.4.0+build"},
+				{val: "1.10.0"},
+				{
+					val: "1.4.0-rc.1",
+					expErr: `"1.4.0-rc.1" should be a semantic version` +
+						` greater than or equal to 1.4.0`,
+				},
+				{
+					val: "1.3.99",
+					expErr: `"1.3.99" should be a semantic version` +
+						` greater than or equal to 1.4.0`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverLT"),
+			expr: `SemverLT("2.0.0")`,
+			valErrs: []valErr[string]{
+				{val: "1.99.99"},
+				{val: "2.0.0-alpha"},
+				{
+					val: "2.0.0",
+					expErr: `"2.0.0" should be a semantic version` +
+						` less than 2.0.0`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverGT: pre-release precedence"),
+			expr: `SemverGT("1.0.0-alpha.1")`,
+			valErrs: []valErr[string]{
+				{val: "1.0.0-alpha.beta"},
+				{val: "1.0.0-beta.2"},
+				{val: "1.0.0-beta.11"},
+				{val: "1.0.0"},
+				{
+					val: "1.0.0-alpha",
+					expErr: `"1.0.0-alpha" should be a semantic version` +
+						` greater than 1.0.0-alpha.1`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverLE and SemverEQ"),
+			expr: `SemverLE("1.0.0-beta.11"), SemverEQ("1.0.0-beta.11")`,
+			valErrs: []valErr[string]{
+				{val: "1.0.0-beta.11+exp.sha.5114f85"},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverMajor"),
+			expr: `SemverMajor(EQ(1))`,
+			valErrs: []valErr[string]{
+				{val: "1.22.3"},
+				{
+					val: "2.0.0",
+					expErr: `the major version of "2.0.0" is incorrect:` +
+						` the value (2) must equal 1`,
+				},
+				{
+					val: "x",
+					expErr: `"x" should be a semantic version:` +
+						` it should have the form MAJOR.MINOR.PATCH`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("SemverMinor and SemverPatch"),
+			expr: `SemverMinor(GE(4)), SemverPatch(LT(10))`,
+			valErrs: []valErr[string]{
+				{val: "1.4.9"},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad version argument"),
+			ExpErr: testhelper.MkExpErr(
+				`SemverGE(semver): bad semantic version: "1.4"`),
+			expr: `SemverGE("1.4")`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+}
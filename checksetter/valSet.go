@@ -0,0 +1,69 @@
+package checksetter
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// valSet records a set of values and a description of them for use in error
+// messages
+type valSet[T cmp.Ordered] struct {
+	vals map[T]struct{}
+	desc string
+}
+
+// mkValSet returns a valSet holding the values. It panics if no values are
+// given.
+func mkValSet[T cmp.Ordered](vals ...T) valSet[T] {
+	if len(vals) == 0 {
+		panic("at least one value must be given")
+	}
+
+	vs := valSet[T]{vals: make(map[T]struct{}, len(vals))}
+	for _, v := range vals {
+		vs.vals[v] = struct{}{}
+	}
+
+	descs := []string{}
+	for _, v := range slices.Sorted(maps.Keys(vs.vals)) {
+		descs = append(descs, fmt.Sprintf("%#v", v))
+	}
+
+	vs.desc = strings.Join(descs, ", ")
+
+	return vs
+}
+
+// valOneOf returns a check func which will return an error if the value is
+// not one of the given values.
+func valOneOf[T cmp.Ordered](vals ...T) check.ValCk[T] {
+	vs := mkValSet(vals...)
+
+	return func(v T) error {
+		if _, ok := vs.vals[v]; ok {
+			return nil
+		}
+
+		return fmt.Errorf("the value (%#v) must be one of: %s", v, vs.desc)
+	}
+}
+
+// valNoneOf returns a check func which will return an error if the value is
+// one of the given values.
+func valNoneOf[T cmp.Ordered](vals ...T) check.ValCk[T] {
+	vs := mkValSet(vals...)
+
+	return func(v T) error {
+		if _, ok := vs.vals[v]; !ok {
+			return nil
+		}
+
+		return fmt.Errorf("the value (%#v) must not be any of: %s",
+			v, vs.desc)
+	}
+}
@@ -0,0 +1,75 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestOneOfNoneOf(t *testing.T) {
+	ip := checksetter.FindParserOrPanic[int](checksetter.IntCheckerName)
+	sp := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr       string
+		isStr      bool
+		valErrs    []valErr[int]
+		strValErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("int: OneOf"),
+			expr: "OneOf(3, 1, 2, 1)",
+			valErrs: []valErr[int]{
+				{val: 1},
+				{val: 3},
+				{val: 4, expErr: "the value (4) must be one of: 1, 2, 3"},
+			},
+		},
+		{
+			ID:   testhelper.MkID("int: NoneOf"),
+			expr: "NoneOf(8080, 443)",
+			valErrs: []valErr[int]{
+				{val: 80},
+				{
+					val:    443,
+					expErr: "the value (443) must not be any of: 443, 8080",
+				},
+			},
+		},
+		{
+			ID:    testhelper.MkID("string: OneOf"),
+			expr:  `OneOf("b", "a")`,
+			isStr: true,
+			strValErrs: []valErr[string]{
+				{val: "a"},
+				{
+					val:    "c",
+					expErr: `the value ("c") must be one of: "a", "b"`,
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: no values"),
+			ExpErr: testhelper.MkExpErr("OneOf(..., int):",
+				"at least one value must be given"),
+			expr: "OneOf()",
+		},
+		{
+			ID: testhelper.MkID("bad: wrong type"),
+			ExpErr: testhelper.MkExpErr("NoneOf(..., int):",
+				`"2.5" isn't an INT, it's a FLOAT`),
+			expr: "NoneOf(1, 2.5)",
+		},
+	}
+
+	for _, tc := range testCases {
+		if tc.isStr {
+			testValErrs(t, tc, sp, tc.expr, tc.strValErrs)
+		} else {
+			testValErrs(t, tc, ip, tc.expr, tc.valErrs)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package checksetter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nickwells/check.mod/v2/check"
+)
+
+// stringContainsAny returns a function that checks that the string contains
+// at least one of the characters in the supplied string
+func stringContainsAny[T ~string](chars string) check.ValCk[T] {
+	return func(v T) error {
+		if !strings.ContainsAny(string(v), chars) {
+			return fmt.Errorf("%q should contain at least one of the"+
+				" characters in %q", v, chars)
+		}
+
+		return nil
+	}
+}
+
+// stringCharsIn returns a function that checks that every character in the
+// string is one of the characters in the supplied string
+func stringCharsIn[T ~string](chars string) check.ValCk[T] {
+	return func(v T) error {
+		for i, r := range string(v) {
+			if !strings.ContainsRune(chars, r) {
+				return fmt.Errorf("%q should only contain characters"+
+					" from %q, the character at position %d (%q) is not"+
+					" allowed", v, chars, i, r)
+			}
+		}
+
+		return nil
+	}
+}
+
+// stringRuneCount returns a function that checks that the number of runes
+// in the string passes the supplied check
+func stringRuneCount[T ~string](cf check.ValCk[int]) check.ValCk[T] {
+	return func(v T) error {
+		rc := utf8.RuneCountInString(string(v))
+
+		err := cf(rc)
+		if err == nil {
+			return nil
+		}
+
+		return fmt.Errorf("the number of runes in the string (%d)"+
+			" is incorrect: %w", rc, err)
+	}
+}
+
+// stringIsUpper checks that the string has no lower-case letters
+func stringIsUpper[T ~string](v T) error {
+	if strings.ToUpper(string(v)) != string(v) {
+		return fmt.Errorf("%q should not contain any lower-case letters", v)
+	}
+
+	return nil
+}
+
+// stringIsLower checks that the string has no upper-case letters
+func stringIsLower[T ~string](v T) error {
+	if strings.ToLower(string(v)) != string(v) {
+		return fmt.Errorf("%q should not contain any upper-case letters", v)
+	}
+
+	return nil
+}
+
+// stringIsASCII checks that the string only contains ASCII characters
+func stringIsASCII[T ~string](v T) error {
+	for i, r := range string(v) {
+		if r > unicode.MaxASCII {
+			return fmt.Errorf("%q should only contain ASCII characters,"+
+				" the character at position %d (%q) is not ASCII", v, i, r)
+		}
+	}
+
+	return nil
+}
+
+// stringIsPrintable checks that the string only contains printable
+// characters
+func stringIsPrintable[T ~string](v T) error {
+	for i, r := range string(v) {
+		if !unicode.IsPrint(r) {
+			return fmt.Errorf("%q should only contain printable characters,"+
+				" the character at position %d (%q) is not printable",
+				v, i, r)
+		}
+	}
+
+	return nil
+}
+
+// stringValidUTF8 checks that the string is valid UTF-8
+func stringValidUTF8[T ~string](v T) error {
+	if !utf8.ValidString(string(v)) {
+		return fmt.Errorf("%q should be valid UTF-8", v)
+	}
+
+	return nil
+}
+
+// stringNoSurroundingSpace checks that the string has no leading or
+// trailing white space
+func stringNoSurroundingSpace[T ~string](v T) error {
+	if strings.TrimSpace(string(v)) != string(v) {
+		return fmt.Errorf("%q should not have leading or trailing white space",
+			v)
+	}
+
+	return nil
+}
+
+// stringAsInt returns a function that checks that the string can be parsed
+// as an int and that the resulting value passes the supplied check
+func stringAsInt[T ~string](cf check.ValCk[int]) check.ValCk[T] {
+	return stringAs[T]("an int", strconv.Atoi, cf)
+}
+
+// stringAsFloat returns a function that checks that the string can be
+// parsed as a float64 and that the resulting value passes the supplied
+// check
+func stringAsFloat[T ~string](cf check.ValCk[float64]) check.ValCk[T] {
+	return stringAs[T]("a float",
+		func(s string) (float64, error) {
+			return strconv.ParseFloat(s, 64)
+		}, cf)
+}
+
+// stringAsDuration returns a function that checks that the string can be
+// parsed as a time.Duration and that the resulting value passes the
+// supplied check
+func stringAsDuration[T ~string](cf check.ValCk[time.Duration]) check.ValCk[T] {
+	return stringAs[T]("a duration", time.ParseDuration, cf)
+}
+
+// stringAsBool returns a function that checks that the string can be parsed
+// as a bool and that the resulting value passes the supplied check
+func stringAsBool[T ~string](cf check.ValCk[bool]) check.ValCk[T] {
+	return stringAs[T]("a bool", strconv.ParseBool, cf)
+}
+
+// stringAsPath returns a function that checks that the string, taken as
+// the name of a file-system object, passes the supplied check
+func stringAsPath[T ~string](cf check.ValCk[string]) check.ValCk[T] {
+	return func(v T) error {
+		return cf(string(v))
+	}
+}
+
+// stringAs returns a function that checks that the string can be converted
+// by the parse func and that the resulting value passes the supplied
+// check. The description is used in any error message.
+func stringAs[T ~string, V any](
+	desc string, parse func(string) (V, error), cf check.ValCk[V],
+) check.ValCk[T] {
+	return func(v T) error {
+		pv, err := parse(string(v))
+		if err != nil {
+			return fmt.Errorf("%q can't be parsed as %s: %w", v, desc, err)
+		}
+
+		if err := cf(pv); err != nil {
+			return fmt.Errorf("the string (%q) as %s is incorrect: %w",
+				v, desc, err)
+		}
+
+		return nil
+	}
+}
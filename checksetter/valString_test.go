@@ -0,0 +1,238 @@
+package checksetter_test
+
+import (
+	"testing"
+
+	"github.com/nickwells/checksetter.mod/v4/checksetter"
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestStringPredicates(t *testing.T) {
+	p := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("Contains"),
+			expr: `Contains("ab")`,
+			valErrs: []valErr[string]{
+				{val: "xaby"},
+				{val: "xa", expErr: `"xa" should contain "ab"`},
+			},
+		},
+		{
+			ID:   testhelper.MkID("ContainsAny"),
+			expr: `ContainsAny("xyz")`,
+			valErrs: []valErr[string]{
+				{val: "abz"},
+				{
+					val: "abc",
+					expErr: `"abc" should contain at least one of the` +
+						` characters in "xyz"`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("EqualFold"),
+			expr: `EqualFold("Go")`,
+			valErrs: []valErr[string]{
+				{val: "GO"},
+				{val: "C", expErr: `"C" should equal "Go" when ignoring case`},
+			},
+		},
+		{
+			ID:   testhelper.MkID("CharsIn"),
+			expr: `CharsIn("abc")`,
+			valErrs: []valErr[string]{
+				{val: ""},
+				{val: "cab"},
+				{
+					val: "cat",
+					expErr: `"cat" should only contain characters from "abc",` +
+						` the character at position 2 ('t') is not allowed`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("RuneCount"),
+			expr: `RuneCount(LT(3))`,
+			valErrs: []valErr[string]{
+				{val: "éé"},
+				{
+					val: "ééé",
+					expErr: "the number of runes in the string (3)" +
+						" is incorrect: the value (3) must be less than 3",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsUpper"),
+			expr: "IsUpper",
+			valErrs: []valErr[string]{
+				{val: "ABC-1"},
+				{
+					val:    "ABc",
+					expErr: `"ABc" should not contain any lower-case letters`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsLower"),
+			expr: "IsLower",
+			valErrs: []valErr[string]{
+				{val: "abc-1"},
+				{
+					val:    "aBc",
+					expErr: `"aBc" should not contain any upper-case letters`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsASCII"),
+			expr: "IsASCII",
+			valErrs: []valErr[string]{
+				{val: "abc"},
+				{
+					val: "aé",
+					expErr: `"aé" should only contain ASCII characters,` +
+						` the character at position 1 ('é') is not ASCII`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("IsPrintable"),
+			expr: "IsPrintable",
+			valErrs: []valErr[string]{
+				{val: "a b"},
+				{
+					val: "a\tb",
+					expErr: `"a\tb" should only contain printable` +
+						` characters, the character at position 1 ('\t')` +
+						` is not printable`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("ValidUTF8"),
+			expr: "ValidUTF8",
+			valErrs: []valErr[string]{
+				{val: "é"},
+				{val: "\xff", expErr: `"\xff" should be valid UTF-8`},
+			},
+		},
+		{
+			ID:   testhelper.MkID("NoSurroundingSpace"),
+			expr: "NoSurroundingSpace",
+			valErrs: []valErr[string]{
+				{val: "a b"},
+				{
+					val: " a",
+					expErr: `" a" should not have leading or trailing` +
+						` white space`,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+}
+
+func TestStringAs(t *testing.T) {
+	p := checksetter.FindParserOrPanic[string](checksetter.StringCheckerName)
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		expr    string
+		valErrs []valErr[string]
+	}{
+		{
+			ID:   testhelper.MkID("AsInt"),
+			expr: "AsInt(GT(0))",
+			valErrs: []valErr[string]{
+				{val: "42"},
+				{
+					val: "0",
+					expErr: `the string ("0") as an int is incorrect:` +
+						" the value (0) must be greater than 0",
+				},
+				{
+					val: "x",
+					expErr: `"x" can't be parsed as an int:` +
+						` strconv.Atoi: parsing "x": invalid syntax`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("AsFloat"),
+			expr: "AsFloat(Between(0, 1))",
+			valErrs: []valErr[string]{
+				{val: "0.5"},
+				{
+					val: "1.5",
+					expErr: `the string ("1.5") as a float is incorrect:` +
+						" the value (1.5) must be between 0 and 1 - too big",
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("AsDuration"),
+			expr: `AsDuration(LT("1h"))`,
+			valErrs: []valErr[string]{
+				{val: "59m"},
+				{
+					val: "2h",
+					expErr: `the string ("2h") as a duration is incorrect:` +
+						" the value (2h0m0s) must be less than 1h0m0s",
+				},
+				{
+					val: "1",
+					expErr: `"1" can't be parsed as a duration:` +
+						` time: missing unit in duration "1"`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("AsBool"),
+			expr: "AsBool(OK)",
+			valErrs: []valErr[string]{
+				{val: "true"},
+				{val: "F"},
+				{
+					val: "yes",
+					expErr: `"yes" can't be parsed as a bool:` +
+						` strconv.ParseBool: parsing "yes": invalid syntax`,
+				},
+			},
+		},
+		{
+			ID:   testhelper.MkID("AsBool, IsTrue"),
+			expr: "AsBool(IsTrue)",
+			valErrs: []valErr[string]{
+				{val: "1"},
+				{
+					val: "false",
+					expErr: `the string ("false") as a bool is incorrect:` +
+						" the value should be true",
+				},
+			},
+		},
+		{
+			ID: testhelper.MkID("bad: AsDuration, bad duration"),
+			ExpErr: testhelper.MkExpErr(
+				"AsDuration(duration-checker):",
+				"LT(duration): bad duration:"),
+			expr: `AsDuration(LT("an hour"))`,
+		},
+	}
+
+	for _, tc := range testCases {
+		testValErrs(t, tc, p, tc.expr, tc.valErrs)
+	}
+}
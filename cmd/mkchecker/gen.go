@@ -0,0 +1,441 @@
+package main
+
+import (
+	"fmt"
+	"go/format"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	generatedHdr = "// Code generated by mkchecker; DO NOT EDIT.\n\n"
+	commentWidth = 76
+
+	checkPkgPath      = "github.com/nickwells/check.mod/v2/check"
+	testhelperPkgPath = "github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+// generator holds the information needed to generate the files for a set of
+// specs
+type generator struct {
+	pkgName  string
+	pkgPath  string
+	families map[string]family
+}
+
+// newGenerator returns a generator which knows about all the families in the
+// specs
+func newGenerator(pkgName, pkgPath string, specs []*spec) (*generator, error) {
+	g := &generator{
+		pkgName:  pkgName,
+		pkgPath:  pkgPath,
+		families: map[string]family{},
+	}
+
+	for _, s := range specs {
+		if _, exists := g.families[s.family.checkerName]; exists {
+			return nil, fmt.Errorf("%s: the family %q is given more than once",
+				s.filename, s.family.checkerName)
+		}
+
+		g.families[s.family.checkerName] = s.family
+	}
+
+	return g, nil
+}
+
+// argInfo records how a maker argument is to be generated. For an argument
+// which is a nested checker the checker field holds the name of the
+// constant giving the checker name.
+type argInfo struct {
+	goType  string
+	decoder string
+	abbrev  string
+	sample  string
+	imports []string
+	checker string
+}
+
+// resolveArg finds the details of the named argument kind
+func (g *generator) resolveArg(s *spec, name string) (argInfo, error) {
+	if k, ok := s.kinds[name]; ok {
+		return argInfo{
+			goType:  k.goType,
+			decoder: k.decoder,
+			abbrev:  k.abbrev,
+			sample:  k.sample,
+			imports: k.imports,
+		}, nil
+	}
+
+	if f, ok := g.families[name]; ok {
+		return argInfo{
+			goType:  "check.ValCk[" + f.goType + "]",
+			decoder: "CheckerArg[" + f.goType + "](" + f.constName + ")",
+			abbrev:  f.abbrev + "checker",
+			sample:  f.sample,
+			checker: f.constName,
+		}, nil
+	}
+
+	return argInfo{}, fmt.Errorf("%s: unknown argument kind: %q", s.filename, name)
+}
+
+// resolvedMaker holds the details of a maker needed to generate the code
+type resolvedMaker struct {
+	*maker
+	varName  string
+	variadic bool
+	args     []argInfo
+}
+
+// argsDesc returns the argument list as it will be reported in the
+// MakerInfo Args
+func (rm resolvedMaker) argsDesc() string {
+	return strings.Join(rm.maker.args, ", ")
+}
+
+// funcType returns the type of the functions in the maker's map
+func (rm resolvedMaker) funcType(retType string) string {
+	if len(rm.args) == 0 {
+		return retType
+	}
+
+	types := make([]string, 0, len(rm.args))
+	for _, a := range rm.args {
+		types = append(types, a.goType)
+	}
+
+	if rm.variadic {
+		types[len(types)-1] = variadicMarker + types[len(types)-1]
+	}
+
+	return "func(" + strings.Join(types, ", ") + ") " + retType
+}
+
+// constructor returns the name of the MakerInfo constructor and the
+// trailing arguments to pass to it
+func (rm resolvedMaker) constructor() (string, []string, error) {
+	decoders := make([]string, 0, len(rm.args))
+	for _, a := range rm.args {
+		decoders = append(decoders, a.decoder)
+	}
+
+	switch {
+	case rm.variadic && len(rm.args) == 1:
+		return "MakerVariadic", decoders, nil
+	case rm.variadic && len(rm.args) == 2: //nolint:mnd
+		return "Maker1Variadic", decoders, nil
+	case rm.variadic:
+	case len(rm.args) == 0:
+		return "MakerNoArgs", nil, nil
+	case len(rm.args) == 1 && rm.args[0].checker != "":
+		return "MakerChecker", []string{rm.args[0].checker}, nil
+	case len(rm.args) == 1:
+		return "Maker1", decoders, nil
+	case len(rm.args) == 2: //nolint:mnd
+		return "Maker2", decoders, nil
+	case len(rm.args) == 3: //nolint:mnd
+		return "Maker3", decoders, nil
+	}
+
+	return "", nil,
+		fmt.Errorf("there is no MakerInfo constructor for args: (%s)",
+			rm.argsDesc())
+}
+
+// sampleArgs returns the sample arguments to be used when testing the
+// named function
+func (rm resolvedMaker) sampleArgs(s *spec, name string) string {
+	if sa, ok := s.samples[name]; ok {
+		return sa
+	}
+
+	samples := make([]string, 0, len(rm.args)+1)
+	for _, a := range rm.args {
+		samples = append(samples, a.sample)
+	}
+
+	if rm.variadic {
+		samples = append(samples, samples[len(samples)-1])
+	}
+
+	return strings.Join(samples, ", ")
+}
+
+// resolveMakers returns the makers from the spec with all the information
+// needed to generate the code
+func (g *generator) resolveMakers(s *spec) ([]resolvedMaker, error) {
+	rms := make([]resolvedMaker, 0, len(s.makers))
+	varNames := map[string]bool{}
+
+	for _, m := range s.makers {
+		rm := resolvedMaker{maker: m}
+		varName := s.family.prefix + "Maker"
+
+		for _, a := range m.args {
+			if a == variadicMarker {
+				rm.variadic = true
+				varName += "Multi"
+
+				continue
+			}
+
+			ai, err := g.resolveArg(s, a)
+			if err != nil {
+				return nil, err
+			}
+
+			rm.args = append(rm.args, ai)
+			varName += ai.abbrev
+		}
+
+		if varNames[varName] {
+			return nil,
+				fmt.Errorf("%s: there is more than one maker with args: (%s)",
+					s.filename, rm.argsDesc())
+		}
+
+		varNames[varName] = true
+		rm.varName = varName
+		rms = append(rms, rm)
+	}
+
+	return rms, nil
+}
+
+// wrapComment returns the text as a sequence of comment lines
+func wrapComment(text string) string {
+	var b strings.Builder
+
+	line := "//"
+
+	for _, w := range strings.Fields(text) {
+		if len(line)+1+len(w) > commentWidth && line != "//" {
+			b.WriteString(line + "\n")
+			line = "//"
+		}
+
+		line += " " + w
+	}
+
+	b.WriteString(line + "\n")
+
+	return b.String()
+}
+
+// writeImports writes the import block
+func writeImports(b *strings.Builder, stdImports, otherImports []string) {
+	slices.Sort(stdImports)
+	stdImports = slices.Compact(stdImports)
+
+	b.WriteString("import (\n")
+
+	for _, imp := range stdImports {
+		fmt.Fprintf(b, "\t%q\n", imp)
+	}
+
+	if len(stdImports) > 0 {
+		b.WriteString("\n")
+	}
+
+	for _, imp := range otherImports {
+		fmt.Fprintf(b, "\t%q\n", imp)
+	}
+
+	b.WriteString(")\n\n")
+}
+
+// genMakerFile returns the contents of the maker file for the spec
+func (g *generator) genMakerFile(s *spec, rms []resolvedMaker) ([]byte, error) {
+	var b strings.Builder
+
+	fam := s.family
+	retType := "check.ValCk[" + fam.goType + "]"
+
+	b.WriteString(generatedHdr)
+	fmt.Fprintf(&b, "package %s\n\n", g.pkgName)
+
+	imports := slices.Clone(s.imports)
+	for _, rm := range rms {
+		for _, a := range rm.args {
+			imports = append(imports, a.imports...)
+		}
+	}
+
+	writeImports(&b, imports, []string{checkPkgPath})
+
+	b.WriteString(wrapComment(fam.constName +
+		" is the value to use to select the Parser to use when" +
+		" creating checkers for " + s.desc))
+	fmt.Fprintf(&b, "const %s = %q\n\n", fam.constName, fam.checkerName)
+
+	for _, rm := range rms {
+		cons, consArgs, err := rm.constructor()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", s.filename, err)
+		}
+
+		fmt.Fprintf(&b, "var %s = %s(\n", rm.varName, cons)
+		fmt.Fprintf(&b, "map[string]%s{\n", rm.funcType(retType))
+
+		for _, mf := range rm.funcs {
+			fmt.Fprintf(&b, "%q: %s,\n", mf.name, mf.goFunc)
+		}
+
+		b.WriteString("}")
+
+		if len(consArgs) > 0 {
+			b.WriteString(",\n" + strings.Join(consArgs, ", "))
+		}
+
+		b.WriteString(")\n\n")
+	}
+
+	b.WriteString("func init() {\n")
+	fmt.Fprintf(&b, "_, err := MakeParser(\n%s,\n", fam.constName)
+	fmt.Fprintf(&b, "map[string]MakerInfo[%s]{\n", fam.goType)
+
+	for _, rm := range rms {
+		for _, mf := range rm.funcs {
+			fmt.Fprintf(&b, "%q: %s,\n", mf.name, rm.varName)
+		}
+	}
+
+	b.WriteString("})\n")
+	b.WriteString("if err != nil {\npanic(err)\n}\n}\n")
+
+	return format.Source([]byte(b.String()))
+}
+
+// testFuncName returns the name of the test func for the generated file
+func testFuncName(file string) string {
+	base := strings.TrimSuffix(file, ".go")
+	r, size := utf8.DecodeRuneInString(base)
+
+	return "Test" + string(unicode.ToUpper(r)) + base[size:]
+}
+
+// testFileName returns the name of the test file for the generated file
+func testFileName(file string) string {
+	return strings.TrimSuffix(file, ".go") + "_test.go"
+}
+
+// genTestFile returns the contents of the test file for the spec
+func (g *generator) genTestFile(s *spec, rms []resolvedMaker) ([]byte, error) {
+	var b strings.Builder
+
+	fam := s.family
+
+	b.WriteString(generatedHdr)
+	fmt.Fprintf(&b, "package %s_test\n\n", g.pkgName)
+	stdImports := []string{"testing"}
+
+	for _, imp := range s.imports {
+		if strings.Contains(fam.goType, path.Base(imp)+".") {
+			stdImports = append(stdImports, imp)
+		}
+	}
+
+	writeImports(&b, stdImports, []string{g.pkgPath, testhelperPkgPath})
+
+	fmt.Fprintf(&b, "func %s(t *testing.T) {\n", testFuncName(s.file))
+	fmt.Fprintf(&b, "parser := %s.FindParserOrPanic[%s](\n%s.%s)\n\n",
+		g.pkgName, fam.goType, g.pkgName, fam.constName)
+
+	b.WriteString("expArgs := map[string][]string{\n")
+
+	names := []string{}
+
+	for _, rm := range rms {
+		for _, mf := range rm.funcs {
+			names = append(names, mf.name)
+
+			args := make([]string, 0, len(rm.maker.args))
+			for _, a := range rm.maker.args {
+				args = append(args, fmt.Sprintf("%q", a))
+			}
+
+			fmt.Fprintf(&b, "%q: {%s},\n", mf.name, strings.Join(args, ", "))
+		}
+	}
+
+	b.WriteString("}\n\n")
+
+	slices.Sort(names)
+	b.WriteString("testhelper.DiffStringSlice(t,\n")
+	fmt.Fprintf(&b, "%q, \"maker names\",\n", fam.checkerName)
+	b.WriteString("parser.Makers(),\n[]string{\n")
+
+	for _, name := range names {
+		fmt.Fprintf(&b, "%q,\n", name)
+	}
+
+	b.WriteString("})\n\n")
+
+	b.WriteString("for name, args := range expArgs {\n")
+	b.WriteString("actArgs, err := parser.Args(name)\n")
+	b.WriteString("if err != nil {\n")
+	b.WriteString("t.Errorf(\"unexpected error getting the args for %s: %s\",\n")
+	b.WriteString("name, err)\n\ncontinue\n}\n\n")
+	fmt.Fprintf(&b, "testhelper.DiffStringSlice(t, %q, \"args for \"+name,\n",
+		fam.checkerName)
+	b.WriteString("actArgs, args)\n}\n\n")
+
+	b.WriteString("testCases := []struct {\n" +
+		"testhelper.ID\n" +
+		"testhelper.ExpErr\n" +
+		"expr string\n" +
+		"}{\n")
+
+	for _, rm := range rms {
+		for _, mf := range rm.funcs {
+			genTestCases(&b, s, rm, mf.name)
+		}
+	}
+
+	b.WriteString("}\n\n")
+	b.WriteString("for _, tc := range testCases {\n" +
+		"_, err := parser.Parse(tc.expr)\n" +
+		"testhelper.CheckExpErr(t, err, tc)\n" +
+		"}\n}\n")
+
+	return format.Source([]byte(b.String()))
+}
+
+// genTestCases writes the test cases for the named function
+func genTestCases(b *strings.Builder, s *spec, rm resolvedMaker, name string) {
+	sample := rm.sampleArgs(s, name)
+
+	if len(rm.args) == 0 {
+		fmt.Fprintf(b, "{\nID: testhelper.MkID(%q),\nexpr: %q,\n},\n",
+			name+": good: no call", name)
+	}
+
+	fmt.Fprintf(b, "{\nID: testhelper.MkID(%q),\nexpr: %q,\n},\n",
+		name+": good", name+"("+sample+")")
+
+	if rm.variadic {
+		return
+	}
+
+	extra := "1"
+	if len(rm.args) > 0 {
+		extra = rm.args[len(rm.args)-1].sample
+	}
+
+	badArgs := extra
+	if sample != "" {
+		badArgs = sample + ", " + extra
+	}
+
+	fmt.Fprintf(b, "{\nID: testhelper.MkID(%q),\n", name+": bad: too many args")
+	fmt.Fprintf(b, "ExpErr: testhelper.MkExpErr(%q,\n%q),\n",
+		name+"("+rm.argsDesc()+"):",
+		fmt.Sprintf("the call has %d arguments, it should have %d",
+			len(rm.args)+1, len(rm.args)))
+	fmt.Fprintf(b, "expr: %q,\n},\n", name+"("+badArgs+")")
+}
@@ -0,0 +1,128 @@
+// mkchecker generates the Go code for a family of checkers from a
+// declarative spec. It writes a file containing the MakerInfo values and the
+// registration of the Parser along with a test file which confirms that
+// every function is registered with the expected arguments.
+//
+// It is intended to be run through go generate.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nickwells/param.mod/v7/param"
+	"github.com/nickwells/param.mod/v7/paramset"
+	"github.com/nickwells/param.mod/v7/psetter"
+)
+
+// prog holds the parameters and state of the program
+type prog struct {
+	specFiles []string
+	outDir    string
+	pkgName   string
+	pkgPath   string
+}
+
+// newProg returns a prog with the default values set
+func newProg() *prog {
+	return &prog{
+		outDir:  ".",
+		pkgName: "checksetter",
+		pkgPath: "github.com/nickwells/checksetter.mod/v4/checksetter",
+	}
+}
+
+// addParams returns a func that will add the program's parameters to the
+// PSet
+func addParams(prog *prog) param.PSetOptFunc {
+	return func(ps *param.PSet) error {
+		ps.Add("spec",
+			psetter.PathnameListAppender{Value: &prog.specFiles},
+			"a file holding the spec for a family of checkers."+
+				" Every family referred to in a spec must be given",
+			param.AltNames("s"),
+			param.Attrs(param.MustBeSet))
+
+		ps.Add("out-dir",
+			psetter.String[string]{Value: &prog.outDir},
+			"the directory in which the files will be written",
+			param.AltNames("o"))
+
+		ps.Add("package",
+			psetter.String[string]{Value: &prog.pkgName},
+			"the name of the package of the generated files")
+
+		ps.Add("package-path",
+			psetter.String[string]{Value: &prog.pkgPath},
+			"the import path of the package, used by the generated tests")
+
+		return nil
+	}
+}
+
+// writeFile writes the contents to the named file in the output directory
+func (prog *prog) writeFile(name string, contents []byte) error {
+	return os.WriteFile(filepath.Join(prog.outDir, name), contents, 0o644) //nolint:gosec,mnd
+}
+
+// run reads the specs and generates the files
+func (prog *prog) run() error {
+	specs := make([]*spec, 0, len(prog.specFiles))
+
+	for _, fn := range prog.specFiles {
+		s, err := readSpecFile(fn)
+		if err != nil {
+			return err
+		}
+
+		specs = append(specs, s)
+	}
+
+	g, err := newGenerator(prog.pkgName, prog.pkgPath, specs)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range specs {
+		rms, err := g.resolveMakers(s)
+		if err != nil {
+			return err
+		}
+
+		code, err := g.genMakerFile(s, rms)
+		if err != nil {
+			return err
+		}
+
+		tests, err := g.genTestFile(s, rms)
+		if err != nil {
+			return err
+		}
+
+		if err := prog.writeFile(s.file, code); err != nil {
+			return err
+		}
+
+		if err := prog.writeFile(testFileName(s.file), tests); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func main() {
+	prog := newProg()
+	ps := paramset.New(addParams(prog),
+		param.SetProgramDescription(
+			"this generates the maker file and tests for each of the"+
+				" checker families described in the spec files"))
+
+	ps.Parse()
+
+	if err := prog.run(); err != nil {
+		fmt.Fprintln(os.Stderr, "mkchecker:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+const variadicMarker = "..."
+
+// kind describes a type of argument that a maker function can take
+type kind struct {
+	name    string
+	goType  string
+	decoder string
+	abbrev  string
+	sample  string
+	imports []string
+}
+
+// builtinKinds returns the argument kinds that are known without needing
+// to be declared in a spec file
+func builtinKinds() map[string]kind {
+	return map[string]kind{
+		"int": {
+			name: "int", goType: "int",
+			decoder: "IntArg", abbrev: "I", sample: "1",
+		},
+		"count": {
+			name: "count", goType: "int",
+			decoder: "CountArg", abbrev: "Cnt", sample: "1",
+		},
+		"int64": {
+			name: "int64", goType: "int64",
+			decoder: "Int64Arg", abbrev: "I64", sample: "1",
+		},
+		"float64": {
+			name: "float64", goType: "float64",
+			decoder: "Float64Arg", abbrev: "F64", sample: "1.5",
+		},
+		"string": {
+			name: "string", goType: "string",
+			decoder: "StringArg", abbrev: "Str", sample: `"a"`,
+		},
+		"duration": {
+			name: "duration", goType: "time.Duration",
+			decoder: "DurationArg", abbrev: "Dur", sample: `"1s"`,
+			imports: []string{"time"},
+		},
+		"regexp": {
+			name: "regexp", goType: "*regexp.Regexp",
+			decoder: "RegexpArg", abbrev: "Regexp", sample: `"a"`,
+			imports: []string{"regexp"},
+		},
+	}
+}
+
+// family describes a family of checkers, all generated by the same Parser
+type family struct {
+	checkerName string
+	constName   string
+	goType      string
+	prefix      string
+	abbrev      string
+	sample      string
+}
+
+// makerFunc records a function name and the Go expression giving the
+// function which will make the check func
+type makerFunc struct {
+	name   string
+	goFunc string
+}
+
+// maker describes a group of functions all taking the same arguments
+type maker struct {
+	args  []string
+	funcs []makerFunc
+}
+
+// spec holds the details read from a spec file
+type spec struct {
+	filename string
+	file     string
+	desc     string
+	family   family
+	imports  []string
+	kinds    map[string]kind
+	makers   []*maker
+	samples  map[string]string
+}
+
+// specLineErr returns an error reporting the problem at the given line of
+// the spec file
+func specLineErr(filename string, lineNum int, format string, a ...any) error {
+	return fmt.Errorf("%s:%d: %s", filename, lineNum, fmt.Sprintf(format, a...))
+}
+
+// readSpecFile opens the named file and reads the spec from it
+func readSpecFile(filename string) (*spec, error) {
+	f, err := os.Open(filename) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return readSpec(filename, f)
+}
+
+// readSpec reads the spec from the reader. The filename is used for error
+// reporting.
+//
+// Each line of the spec starts with a keyword and blank lines and lines
+// starting with a '#' are ignored. The keywords are:
+//
+//	file    the name of the Go file to be generated
+//	family  checker-name ConstName go-type var-prefix abbreviation
+//	desc    the type of value being checked, for the doc comment
+//	import  an extra package to import
+//	kind    name go-type DecoderName abbreviation sample-value
+//	maker   the argument kinds of the following funcs (may be empty)
+//	func    Name GoExpression
+//	sample  Name the arguments to use when testing the named func
+func readSpec(filename string, r io.Reader) (*spec, error) {
+	s := &spec{
+		filename: filename,
+		kinds:    builtinKinds(),
+		samples:  map[string]string{},
+	}
+
+	var currentMaker *maker
+
+	seenFuncs := map[string]int{}
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+
+	for scanner.Scan() {
+		lineNum++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		keyword, rest, _ := strings.Cut(line, " ")
+		rest = strings.TrimSpace(rest)
+		fields := strings.Fields(rest)
+
+		switch keyword {
+		case "file":
+			if len(fields) != 1 {
+				return nil, specLineErr(filename, lineNum,
+					"file: expected a single filename")
+			}
+
+			s.file = fields[0]
+		case "desc":
+			s.desc = rest
+		case "import":
+			if len(fields) != 1 {
+				return nil, specLineErr(filename, lineNum,
+					"import: expected a single package path")
+			}
+
+			s.imports = append(s.imports, fields[0])
+		case "family":
+			const familyFields = 5
+			if len(fields) != familyFields {
+				return nil, specLineErr(filename, lineNum,
+					"family: expected %d fields, found %d",
+					familyFields, len(fields))
+			}
+
+			s.family = family{
+				checkerName: fields[0],
+				constName:   fields[1],
+				goType:      fields[2],
+				prefix:      fields[3],
+				abbrev:      fields[4],
+				sample:      "OK",
+			}
+		case "kind":
+			const kindFields = 5
+			if len(fields) < kindFields {
+				return nil, specLineErr(filename, lineNum,
+					"kind: expected at least %d fields, found %d",
+					kindFields, len(fields))
+			}
+
+			s.kinds[fields[0]] = kind{
+				name:    fields[0],
+				goType:  fields[1],
+				decoder: fields[2],
+				abbrev:  fields[3],
+				sample:  strings.Join(fields[4:], " "),
+			}
+		case "maker":
+			currentMaker = &maker{args: fields}
+			s.makers = append(s.makers, currentMaker)
+		case "func":
+			if currentMaker == nil {
+				return nil, specLineErr(filename, lineNum,
+					"func: there is no preceding maker")
+			}
+
+			name, goFunc, ok := strings.Cut(rest, " ")
+			if !ok {
+				return nil, specLineErr(filename, lineNum,
+					"func: expected a name and a Go expression")
+			}
+
+			if prev, ok := seenFuncs[name]; ok {
+				return nil, specLineErr(filename, lineNum,
+					"func: %q was already given at line %d", name, prev)
+			}
+
+			seenFuncs[name] = lineNum
+			currentMaker.funcs = append(currentMaker.funcs,
+				makerFunc{name: name, goFunc: strings.TrimSpace(goFunc)})
+		case "sample":
+			name, args, ok := strings.Cut(rest, " ")
+			if !ok {
+				return nil, specLineErr(filename, lineNum,
+					"sample: expected a name and the sample arguments")
+			}
+
+			s.samples[name] = strings.TrimSpace(args)
+		default:
+			return nil, specLineErr(filename, lineNum,
+				"unknown keyword: %q", keyword)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return s, s.check()
+}
+
+// check confirms that the spec is complete
+func (s *spec) check() error {
+	var errs []error
+
+	if s.file == "" {
+		errs = append(errs, fmt.Errorf("%s: no file was given", s.filename))
+	}
+
+	if s.family.checkerName == "" {
+		errs = append(errs, fmt.Errorf("%s: no family was given", s.filename))
+	}
+
+	for name := range s.samples {
+		if !s.hasFunc(name) {
+			errs = append(errs,
+				fmt.Errorf("%s: a sample is given for an unknown func: %q",
+					s.filename, name))
+		}
+	}
+
+	for i, m := range s.makers {
+		if len(m.funcs) == 0 {
+			errs = append(errs,
+				fmt.Errorf("%s: maker %d has no funcs", s.filename, i+1))
+		}
+
+		for j, a := range m.args {
+			if a == variadicMarker && j != len(m.args)-2 {
+				errs = append(errs,
+					fmt.Errorf("%s: maker %d: %q must precede the final arg",
+						s.filename, i+1, variadicMarker))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// hasFunc returns true if the spec has a func with the given name
+func (s *spec) hasFunc(name string) bool {
+	for _, m := range s.makers {
+		for _, mf := range m.funcs {
+			if mf.name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
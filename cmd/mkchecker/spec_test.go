@@ -0,0 +1,110 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/nickwells/testhelper.mod/v2/testhelper"
+)
+
+func TestReadSpec(t *testing.T) {
+	const goodSpec = `
+# a comment
+file    makerX.go
+family  x-checker XCheckerName int x X
+desc    x values
+
+maker
+func    OK check.ValOK[int]
+
+maker   int x-checker
+func    F f
+sample  F 2, OK
+`
+
+	testCases := []struct {
+		testhelper.ID
+		testhelper.ExpErr
+		spec        string
+		expFuncs    int
+		expVarNames []string
+	}{
+		{
+			ID:          testhelper.MkID("good"),
+			spec:        goodSpec,
+			expFuncs:    2,
+			expVarNames: []string{"xMaker", "xMakerIXchecker"},
+		},
+		{
+			ID:     testhelper.MkID("bad: unknown keyword"),
+			ExpErr: testhelper.MkExpErr(`test:2: unknown keyword: "fun"`),
+			spec:   "file makerX.go\nfun OK check.ValOK[int]\n",
+		},
+		{
+			ID:     testhelper.MkID("bad: func before maker"),
+			ExpErr: testhelper.MkExpErr("test:1: func: there is no preceding maker"),
+			spec:   "func OK check.ValOK[int]\n",
+		},
+		{
+			ID:     testhelper.MkID("bad: duplicate func"),
+			ExpErr: testhelper.MkExpErr(`test:3: func: "OK" was already given at line 2`),
+			spec:   "maker\nfunc OK a\nfunc OK b\n",
+		},
+		{
+			ID: testhelper.MkID("bad: incomplete"),
+			ExpErr: testhelper.MkExpErr(
+				"test: no file was given",
+				"test: no family was given",
+				`test: a sample is given for an unknown func: "G"`,
+				"test: maker 1 has no funcs"),
+			spec: "maker int\nsample G 1\n",
+		},
+		{
+			ID:     testhelper.MkID("bad: unknown kind"),
+			ExpErr: testhelper.MkExpErr(`test: unknown argument kind: "y-checker"`),
+			spec: "file makerX.go\nfamily x-checker XCheckerName int x X\n" +
+				"maker y-checker\nfunc F f\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		s, err := readSpec("test", strings.NewReader(tc.spec))
+		if err == nil {
+			var g *generator
+
+			g, err = newGenerator("pkg", "pkgpath", []*spec{s})
+			if err == nil {
+				var rms []resolvedMaker
+
+				rms, err = g.resolveMakers(s)
+				if err == nil {
+					varNames := []string{}
+					funcs := 0
+
+					for _, rm := range rms {
+						varNames = append(varNames, rm.varName)
+						funcs += len(rm.funcs)
+					}
+
+					testhelper.DiffStringSlice(t, tc.IDStr(), "var names",
+						varNames, tc.expVarNames)
+					testhelper.DiffInt(t, tc.IDStr(), "funcs",
+						funcs, tc.expFuncs)
+				}
+			}
+		}
+
+		testhelper.CheckExpErr(t, err, tc)
+	}
+}
+
+func TestNames(t *testing.T) {
+	testhelper.DiffString(t, "testFuncName", "makerInt.go",
+		testFuncName("makerInt.go"), "TestMakerInt")
+	testhelper.DiffString(t, "testFileName", "makerInt.go",
+		testFileName("makerInt.go"), "makerInt_test.go")
+	testhelper.DiffString(t, "wrapComment", "long text",
+		wrapComment(strings.Repeat("word ", 20)),
+		"// word word word word word word word word word word word word word word\n"+
+			"// word word word word word word\n")
+}
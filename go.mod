@@ -2,8 +2,10 @@ module github.com/nickwells/checksetter.mod/v4
 
 require (
 	github.com/nickwells/check.mod/v2 v2.1.29
+	github.com/nickwells/filecheck.mod v1.2.13
 	github.com/nickwells/param.mod/v7 v7.2.4
 	github.com/nickwells/testhelper.mod/v2 v2.6.1
+	golang.org/x/sys v0.45.0
 )
 
 require github.com/nickwells/col.mod/v6 v6.1.1 // indirect
@@ -11,7 +13,6 @@ require github.com/nickwells/col.mod/v6 v6.1.1 // indirect
 require (
 	github.com/nickwells/english.mod v1.2.10 // indirect
 	github.com/nickwells/errutil.mod v1.2.24 // indirect
-	github.com/nickwells/filecheck.mod v1.2.13 // indirect
 	github.com/nickwells/fileparse.mod v1.1.39 // indirect
 	github.com/nickwells/location.mod v1.2.37 // indirect
 	github.com/nickwells/mathutil.mod/v2 v2.5.11 // indirect
@@ -21,7 +22,6 @@ require (
 	github.com/nickwells/twrap.mod v1.5.14 // indirect
 	github.com/nickwells/xdg.mod v1.0.12 // indirect
 	golang.org/x/exp v0.0.0-20260508232706-74f9aab9d74a // indirect
-	golang.org/x/sys v0.45.0 // indirect
 	golang.org/x/term v0.43.0 // indirect
 )
 